@@ -0,0 +1,180 @@
+package ciphersuites
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/dsa"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+)
+
+// Assessment is the classification of a certificate, or part of one, along
+// with the reasons for it.
+type Assessment struct {
+	Classification Classification
+	Reasons        []string
+}
+
+// ClassifyPublicKey classifies a certificate public key, as found in
+// [x509.Certificate.PublicKey], by its algorithm and size.
+//
+// RSA keys of at least 3072 bits and ECDSA keys on the NIST P-256, P-384 and
+// P-521 curves are [Recommended], as are Ed25519 keys. 2048 bit RSA keys,
+// which provide 112 bits of security, are [Secure], and shorter RSA keys,
+// including 1024 bit keys, are [Insecure]. Other curves and DSA keys are
+// [Weak] or [Insecure]. The ssh package classifies RSA host keys the same way.
+func ClassifyPublicKey(pub crypto.PublicKey) Assessment {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		bits := pub.N.BitLen()
+		switch {
+		case bits < 2048:
+			return assess(Insecure, "%d bit RSA keys provide less than 112 bits of security and are within reach of factoring", bits)
+		case bits < 3072:
+			return assess(Secure, "%d bit RSA keys provide 112 bits of security", bits)
+		default:
+			return assess(Recommended, "%d bit RSA keys provide at least 128 bits of security", bits)
+		}
+	case *ecdsa.PublicKey:
+		name := pub.Curve.Params().Name
+		switch name {
+		case "P-256", "P-384", "P-521":
+			return assess(Recommended, "ECDSA keys on %s provide at least 128 bits of security", name)
+		case "P-224":
+			return assess(Weak, "ECDSA keys on P-224 provide 112 bits of security and cannot be used with TLS 1.3")
+		default:
+			return assess(Weak, "ECDSA keys on %s are not supported by TLS implementations", name)
+		}
+	case ed25519.PublicKey:
+		return assess(Recommended, "Ed25519 keys provide 128 bits of security")
+	case *dsa.PublicKey:
+		bits := pub.P.BitLen()
+		if bits < 2048 {
+			return assess(Insecure, "%d bit DSA keys provide less than 112 bits of security", bits)
+		}
+		return assess(Weak, "DSA is withdrawn by FIPS 186-5 and cannot be used with TLS 1.3")
+	default:
+		return assess(Unknown, "unsupported public key type %T", pub)
+	}
+}
+
+// ClassifySignatureAlgorithm classifies the algorithm a certificate is signed
+// with, as found in [x509.Certificate.SignatureAlgorithm].
+//
+// Signatures using MD5 or SHA-1 are [Insecure], because collisions in these
+// hashes have been used to forge certificates. The ssh package classifies
+// SHA-1 host key signatures, such as ssh-rsa, the same way. RSA-PSS, ECDSA and Ed25519
+// signatures with a SHA-2 hash are [Recommended], and RSA PKCS #1 v1.5
+// signatures with a SHA-2 hash are [Secure].
+func ClassifySignatureAlgorithm(alg x509.SignatureAlgorithm) Assessment {
+	switch alg {
+	case x509.MD2WithRSA, x509.MD5WithRSA:
+		return assess(Insecure, "%s signatures can be forged using hash collisions", alg)
+	case x509.SHA1WithRSA, x509.DSAWithSHA1, x509.ECDSAWithSHA1:
+		return assess(Insecure, "%s signatures can be forged using SHA-1 collisions", alg)
+	case x509.DSAWithSHA256:
+		return assess(Weak, "DSA signatures are withdrawn by FIPS 186-5")
+	case x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA:
+		return assess(Secure, "%s uses PKCS #1 v1.5 padding, which lacks the security proof of RSA-PSS", alg)
+	case x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS,
+		x509.ECDSAWithSHA256, x509.ECDSAWithSHA384, x509.ECDSAWithSHA512,
+		x509.PureEd25519:
+		return assess(Recommended, "%s signatures are secure", alg)
+	default:
+		return assess(Unknown, "unsupported signature algorithm %s", alg)
+	}
+}
+
+// CertificateAssessment is the classification of a certificate.
+type CertificateAssessment struct {
+	Certificate *x509.Certificate
+	PublicKey   Assessment
+	Signature   Assessment
+
+	// Classification is the weaker of the public key and signature
+	// classifications.
+	Classification Classification
+}
+
+// ClassifyCertificate classifies the public key and signature algorithm of
+// cert.
+func ClassifyCertificate(cert *x509.Certificate) CertificateAssessment {
+	a := CertificateAssessment{
+		Certificate: cert,
+		PublicKey:   ClassifyPublicKey(cert.PublicKey),
+		Signature:   ClassifySignatureAlgorithm(cert.SignatureAlgorithm),
+	}
+	a.Classification = weaker(a.PublicKey.Classification, a.Signature.Classification)
+	return a
+}
+
+// ChainAssessment is the classification of a certificate chain.
+type ChainAssessment struct {
+	// Certificates classifies each certificate in the chain, in order.
+	Certificates []CertificateAssessment
+
+	// Classification is that of the weakest certificate in the chain,
+	// ignoring the signature of a self-signed root. Reasons explains it,
+	// naming the certificate each reason applies to.
+	Classification Classification
+	Reasons        []string
+}
+
+// ClassifyChain classifies a certificate chain, given with the leaf
+// certificate first. The signature of a self-signed root certificate at the
+// end of the chain does not contribute to the classification, because
+// clients trust the root itself rather than its signature.
+func ClassifyChain(chain []*x509.Certificate) ChainAssessment {
+	var a ChainAssessment
+	if len(chain) == 0 {
+		return a
+	}
+
+	a.Classification = Recommended
+	for i, cert := range chain {
+		ca := ClassifyCertificate(cert)
+		a.Certificates = append(a.Certificates, ca)
+
+		parts := []Assessment{ca.PublicKey}
+		if i < len(chain)-1 || !isSelfSigned(cert) {
+			parts = append(parts, ca.Signature)
+		}
+		for _, part := range parts {
+			a.Classification = weaker(a.Classification, part.Classification)
+			for _, reason := range part.Reasons {
+				a.Reasons = append(a.Reasons, fmt.Sprintf("%s: %s", subject(cert), reason))
+			}
+		}
+	}
+	return a
+}
+
+func assess(c Classification, format string, args ...interface{}) Assessment {
+	return Assessment{Classification: c, Reasons: []string{fmt.Sprintf(format, args...)}}
+}
+
+// weaker returns the weaker of two classifications.
+func weaker(a, b Classification) Classification {
+	if b.rank() < a.rank() {
+		return b
+	}
+	return a
+}
+
+// isSelfSigned reports whether cert is issued by its own subject. The
+// signature is not verified, since crypto/x509 refuses to verify the SHA-1
+// signatures of many older roots.
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject)
+}
+
+// subject names a certificate in a reason.
+func subject(cert *x509.Certificate) string {
+	if s := cert.Subject.String(); s != "" {
+		return s
+	}
+	return fmt.Sprintf("serial %s", cert.SerialNumber)
+}
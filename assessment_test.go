@@ -0,0 +1,158 @@
+package ciphersuites_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/internal/testcert"
+)
+
+func TestClassifyPublicKey(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		key  crypto.PublicKey
+		want ciphersuites.Classification
+	}{
+		"RSA 1024": {
+			key:  rsaPublicKey(1024),
+			want: ciphersuites.Insecure,
+		},
+		"RSA 512": {
+			key:  rsaPublicKey(512),
+			want: ciphersuites.Insecure,
+		},
+		"RSA 2048": {
+			key:  rsaPublicKey(2048),
+			want: ciphersuites.Secure,
+		},
+		"RSA 4096": {
+			key:  rsaPublicKey(4096),
+			want: ciphersuites.Recommended,
+		},
+		"P-256": {
+			key:  &ecdsa.PublicKey{Curve: elliptic.P256()},
+			want: ciphersuites.Recommended,
+		},
+		"P-224": {
+			key:  &ecdsa.PublicKey{Curve: elliptic.P224()},
+			want: ciphersuites.Weak,
+		},
+		"Ed25519": {
+			key:  make(ed25519.PublicKey, ed25519.PublicKeySize),
+			want: ciphersuites.Recommended,
+		},
+		"unsupported": {
+			key:  "not a key",
+			want: ciphersuites.Unknown,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ciphersuites.ClassifyPublicKey(tt.key)
+			if got.Classification != tt.want {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.Classification, tt.want)
+			}
+			if len(got.Reasons) == 0 {
+				t.Error("expected a reason")
+			}
+		})
+	}
+}
+
+func TestClassifySignatureAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		algorithm x509.SignatureAlgorithm
+		want      ciphersuites.Classification
+	}{
+		"MD5": {
+			algorithm: x509.MD5WithRSA,
+			want:      ciphersuites.Insecure,
+		},
+		"SHA-1": {
+			algorithm: x509.SHA1WithRSA,
+			want:      ciphersuites.Insecure,
+		},
+		"ECDSA with SHA-1": {
+			algorithm: x509.ECDSAWithSHA1,
+			want:      ciphersuites.Insecure,
+		},
+		"PKCS #1 v1.5": {
+			algorithm: x509.SHA256WithRSA,
+			want:      ciphersuites.Secure,
+		},
+		"RSA-PSS": {
+			algorithm: x509.SHA256WithRSAPSS,
+			want:      ciphersuites.Recommended,
+		},
+		"ECDSA": {
+			algorithm: x509.ECDSAWithSHA384,
+			want:      ciphersuites.Recommended,
+		},
+		"Ed25519": {
+			algorithm: x509.PureEd25519,
+			want:      ciphersuites.Recommended,
+		},
+		"unknown": {
+			algorithm: x509.UnknownSignatureAlgorithm,
+			want:      ciphersuites.Unknown,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ciphersuites.ClassifySignatureAlgorithm(tt.algorithm)
+			if got.Classification != tt.want {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.Classification, tt.want)
+			}
+			if len(got.Reasons) == 0 {
+				t.Error("expected a reason")
+			}
+		})
+	}
+}
+
+func TestClassifyChain(t *testing.T) {
+	t.Parallel()
+
+	root := testcert.SelfSigned(testcert.ECDSAKey(), 0)
+	leaf := testcert.Issued(testcert.RSAKey(), "leaf.example.com", root, testcert.ECDSAKey())
+
+	a := ciphersuites.ClassifyChain([]*x509.Certificate{leaf, root})
+	if len(a.Certificates) != 2 {
+		t.Fatalf("mismatch:\n  got:  %d certificates\n  want: 2", len(a.Certificates))
+	}
+	if got := a.Certificates[0].Classification; got != ciphersuites.Secure {
+		t.Errorf("leaf mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Secure)
+	}
+	if got := a.Classification; got != ciphersuites.Secure {
+		t.Errorf("chain mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Secure)
+	}
+	if !strings.HasPrefix(a.Reasons[0], "CN=leaf.example.com: ") {
+		t.Errorf("reason does not name the certificate: %s", a.Reasons[0])
+	}
+
+	if got := ciphersuites.ClassifyChain(nil).Classification; got != ciphersuites.Unknown {
+		t.Errorf("empty chain mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Unknown)
+	}
+}
+
+func rsaPublicKey(bits int) *rsa.PublicKey {
+	n := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	return &rsa.PublicKey{N: n, E: 65537}
+}
@@ -0,0 +1,73 @@
+package ciphersuites
+
+import "strings"
+
+// Attack describes a published attack on TLS cipher suites.
+type Attack struct {
+	// ID is a short, stable identifier for the attack, such as "sweet32".
+	ID   string
+	Name string
+
+	// CVEs lists the vulnerabilities assigned to the attack, including those
+	// assigned to individual vulnerable implementations.
+	CVEs []string
+
+	Description string
+	References  []string
+
+	// patterns are substrings of the names of the affected cipher suites.
+	patterns []string
+
+	// versions, if not empty, restricts the attack to cipher suites that can
+	// be negotiated with one of these protocol versions.
+	versions []string
+}
+
+// AttackCatalogue returns every attack known to the package.
+func AttackCatalogue() []Attack {
+	return append([]Attack(nil), attackCatalogue...)
+}
+
+// GetAttack retrieves the [Attack] by its ID.
+func GetAttack(id string) (Attack, bool) {
+	for _, attack := range attackCatalogue {
+		if attack.ID == id {
+			return attack, true
+		}
+	}
+	return Attack{}, false
+}
+
+// AppliesTo reports whether the attack applies to cs, judged by its name and
+// the protocol versions it can be negotiated with.
+func (a Attack) AppliesTo(cs CipherSuite) bool {
+	matched := false
+	for _, p := range a.patterns {
+		matched = matched || strings.Contains(cs.Name, p)
+	}
+	if !matched || len(a.versions) == 0 {
+		return matched
+	}
+
+	for _, v := range cs.TLSVersions {
+		for _, affected := range a.versions {
+			if v == affected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Attacks returns the attacks in the catalogue that apply to the cipher
+// suite, such as Lucky Thirteen for CBC cipher suites or Sweet32 for 64-bit
+// block ciphers.
+func (a CipherSuite) Attacks() []Attack {
+	var attacks []Attack
+	for _, attack := range attackCatalogue {
+		if attack.AppliesTo(a) {
+			attacks = append(attacks, attack)
+		}
+	}
+	return attacks
+}
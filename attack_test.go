@@ -0,0 +1,88 @@
+package ciphersuites_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestCipherSuiteAttacks(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite string
+		want        []string
+	}{
+		"CBC with TLS 1.0": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+			want:        []string{"beast", "lucky13"},
+		},
+		"CBC requiring TLS 1.2": {
+			cipherSuite: "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384",
+			want:        []string{"lucky13"},
+		},
+		"3DES with RSA key transport": {
+			cipherSuite: "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+			want:        []string{"beast", "lucky13", "sweet32", "robot"},
+		},
+		"RC4": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_RC4_128_SHA",
+			want:        []string{"rc4-nomore"},
+		},
+		"RSA export": {
+			cipherSuite: "TLS_RSA_EXPORT_WITH_RC4_40_MD5",
+			want:        []string{"rc4-nomore", "freak", "robot"},
+		},
+		"DHE export": {
+			cipherSuite: "TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA",
+			want:        []string{"beast", "lucky13", "sweet32", "logjam"},
+		},
+		"static DH": {
+			cipherSuite: "TLS_DH_RSA_WITH_AES_128_GCM_SHA256",
+			want:        []string{"raccoon"},
+		},
+		"TLS 1.3": {
+			cipherSuite: "TLS_AES_128_GCM_SHA256",
+			want:        nil,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cs, ok := ciphersuites.GetCipherSuite(tt.cipherSuite)
+			if !ok {
+				t.Fatal("cipher suite not found")
+			}
+
+			var got []string
+			for _, attack := range cs.Attacks() {
+				got = append(got, attack.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttackCatalogue(t *testing.T) {
+	t.Parallel()
+
+	for _, attack := range ciphersuites.AttackCatalogue() {
+		if attack.Name == "" || attack.Description == "" || len(attack.References) == 0 {
+			t.Errorf("mismatch:\n  got:  %+v\n  want: a name, description and references", attack)
+		}
+		for _, cve := range attack.CVEs {
+			if !strings.HasPrefix(cve, "CVE-") {
+				t.Errorf("mismatch:\n  got:  %q\n  want: a CVE ID", cve)
+			}
+		}
+		if got, ok := ciphersuites.GetAttack(attack.ID); !ok || got.Name != attack.Name {
+			t.Errorf("mismatch:\n  got:  %v\n  want: %s", got.Name, attack.Name)
+		}
+	}
+}
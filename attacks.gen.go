@@ -0,0 +1,95 @@
+// Code generated by cipher suite generator. DO NOT EDIT.
+// Generated at: 2026-10-19T01:06:57Z
+
+package ciphersuites
+
+// attackCatalogue lists the published attacks on cipher suites.
+var attackCatalogue = []Attack{
+	{
+		ID:          "beast",
+		Name:        "BEAST",
+		CVEs:        []string{"CVE-2011-3389"},
+		Description: "A chosen-plaintext attack on the predictable initialisation vectors of CBC mode in SSL 3.0 and TLS 1.0, allowing an attacker who can inject requests to recover secrets such as cookies.",
+		References: []string{
+			"https://nvd.nist.gov/vuln/detail/CVE-2011-3389",
+			"https://www.rfc-editor.org/rfc/rfc7457",
+		},
+		patterns: []string{"_CBC_"},
+		versions: []string{"SSL3.0", "TLS1.0"},
+	},
+	{
+		ID:          "lucky13",
+		Name:        "Lucky Thirteen",
+		CVEs:        []string{"CVE-2013-0169"},
+		Description: "A timing side channel in the MAC-then-encrypt construction of CBC cipher suites that acts as a padding oracle, allowing plaintext to be recovered.",
+		References: []string{
+			"https://nvd.nist.gov/vuln/detail/CVE-2013-0169",
+			"http://www.isg.rhul.ac.uk/tls/Lucky13.html",
+		},
+		patterns: []string{"_CBC_"},
+	},
+	{
+		ID:          "sweet32",
+		Name:        "Sweet32",
+		CVEs:        []string{"CVE-2016-2183"},
+		Description: "A birthday attack on 64-bit block ciphers such as 3DES, which recovers plaintext from long-lived connections once around 2^32 blocks have been encrypted under the same key.",
+		References: []string{
+			"https://nvd.nist.gov/vuln/detail/CVE-2016-2183",
+			"https://sweet32.info/",
+		},
+		patterns: []string{"_3DES_", "_DES_", "_DES40_", "_IDEA_", "_RC2_"},
+	},
+	{
+		ID:          "rc4-nomore",
+		Name:        "RC4 NOMORE",
+		CVEs:        []string{"CVE-2013-2566", "CVE-2015-2808"},
+		Description: "Statistical biases in the RC4 keystream allow secrets such as cookies and passwords that are encrypted repeatedly to be recovered.",
+		References: []string{
+			"https://www.rc4nomore.com/",
+			"https://www.rfc-editor.org/rfc/rfc7465",
+		},
+		patterns: []string{"_RC4_"},
+	},
+	{
+		ID:          "freak",
+		Name:        "FREAK",
+		CVEs:        []string{"CVE-2015-0204"},
+		Description: "A man-in-the-middle can force the use of 512-bit export RSA keys, which can be factored in hours, and then decrypt the connection.",
+		References: []string{
+			"https://nvd.nist.gov/vuln/detail/CVE-2015-0204",
+			"https://mitls.org/pages/attacks/SMACK",
+		},
+		patterns: []string{"TLS_RSA_EXPORT"},
+	},
+	{
+		ID:          "logjam",
+		Name:        "Logjam",
+		CVEs:        []string{"CVE-2015-4000"},
+		Description: "A man-in-the-middle can downgrade connections to 512-bit export Diffie-Hellman groups, whose discrete logarithms can be precomputed, and then decrypt the connection.",
+		References: []string{
+			"https://nvd.nist.gov/vuln/detail/CVE-2015-4000",
+			"https://weakdh.org/",
+		},
+		patterns: []string{"_DHE_RSA_EXPORT", "_DHE_DSS_EXPORT", "_DH_RSA_EXPORT", "_DH_DSS_EXPORT", "_DH_anon_EXPORT"},
+	},
+	{
+		ID:          "robot",
+		Name:        "ROBOT",
+		CVEs:        []string{"CVE-2017-13099", "CVE-2017-13098", "CVE-2017-1000385", "CVE-2017-6168", "CVE-2017-17382", "CVE-2017-17427", "CVE-2017-17428"},
+		Description: "Servers that reveal whether RSA PKCS #1 v1.5 padding is valid act as a Bleichenbacher oracle, allowing recorded sessions using RSA key transport to be decrypted.",
+		References: []string{
+			"https://robotattack.org/",
+		},
+		patterns: []string{"TLS_RSA_WITH_", "TLS_RSA_EXPORT", "TLS_RSA_PSK_WITH_"},
+	},
+	{
+		ID:          "raccoon",
+		Name:        "Raccoon",
+		CVEs:        []string{"CVE-2020-1968", "CVE-2020-5929", "CVE-2020-12413"},
+		Description: "A timing side channel in the handling of leading zero bytes of the Diffie-Hellman shared secret in TLS 1.2 and earlier, allowing the premaster secret to be recovered when the server reuses its Diffie-Hellman key.",
+		References: []string{
+			"https://raccoon-attack.com/",
+		},
+		patterns: []string{"TLS_DH_"},
+	},
+}
@@ -0,0 +1,71 @@
+package ciphersuites
+
+import "strings"
+
+// KeyExchange returns the key exchange algorithm named by the cipher suite,
+// such as "ECDHE", "DHE", "RSA" or "PSK". TLS 1.3 cipher suites do not
+// specify a key exchange, and "any" is returned for them.
+func (a CipherSuite) KeyExchange() string {
+	kex, _ := splitKeyExchange(a.Name)
+	if kex == "" {
+		return "any"
+	}
+	return kex
+}
+
+// Authentication returns the algorithm with which the server authenticates
+// itself, such as "RSA", "ECDSA", "DSS", "PSK" or "anon". TLS 1.3 cipher
+// suites can be used with any type of certificate, and "any" is returned for
+// them.
+//
+// Cipher suites that combine a pre-shared key with a certificate, such as
+// TLS_RSA_PSK_WITH_AES_128_GCM_SHA256, report the type of the certificate.
+// Static Diffie-Hellman cipher suites, such as
+// TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256, report the algorithm the certificate
+// is signed with.
+func (a CipherSuite) Authentication() string {
+	_, auth := splitKeyExchange(a.Name)
+	if auth == "" {
+		return "any"
+	}
+	return auth
+}
+
+// splitKeyExchange splits the key exchange and authentication algorithms out
+// of a cipher suite name, ignoring any export restriction. Both are empty for
+// cipher suites that do not name them.
+func splitKeyExchange(name string) (kex, auth string) {
+	i := strings.Index(name, "_WITH_")
+	if i < 0 {
+		return "", ""
+	}
+
+	var parts []string
+	for _, part := range strings.Split(strings.TrimPrefix(name[:i], "TLS_"), "_") {
+		if !strings.HasPrefix(part, "EXPORT") {
+			parts = append(parts, part)
+		}
+	}
+
+	switch {
+	case len(parts) == 0:
+		return "", ""
+	case strings.HasPrefix(parts[0], "GOSTR"):
+		// TLS_GOSTR341112_256_WITH_...: the digits are part of the name.
+		return parts[0], parts[0]
+	case parts[0] == "SRP":
+		// TLS_SRP_SHA_WITH_... authenticates with the password alone, while
+		// TLS_SRP_SHA_RSA_WITH_... adds a certificate.
+		if len(parts) > 2 {
+			return "SRP", parts[len(parts)-1]
+		}
+		return "SRP", "SRP"
+	case parts[0] == "PSK" && len(parts) > 1:
+		// TLS_PSK_DHE_WITH_...
+		return parts[1], "PSK"
+	case len(parts) > 1 && parts[len(parts)-1] == "PSK" && parts[0] == "RSA":
+		return "RSA", "RSA"
+	default:
+		return parts[0], parts[len(parts)-1]
+	}
+}
@@ -0,0 +1,87 @@
+package ciphersuites_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestKeyExchangeAndAuthentication(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite    string
+		keyExchange    string
+		authentication string
+	}{
+		"TLS 1.3": {
+			cipherSuite:    "TLS_AES_128_GCM_SHA256",
+			keyExchange:    "any",
+			authentication: "any",
+		},
+		"ephemeral ECDH with ECDSA": {
+			cipherSuite:    "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+			keyExchange:    "ECDHE",
+			authentication: "ECDSA",
+		},
+		"static RSA": {
+			cipherSuite:    "TLS_RSA_WITH_AES_128_CBC_SHA",
+			keyExchange:    "RSA",
+			authentication: "RSA",
+		},
+		"static ECDH signed with RSA": {
+			cipherSuite:    "TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256",
+			keyExchange:    "ECDH",
+			authentication: "RSA",
+		},
+		"export": {
+			cipherSuite:    "TLS_DHE_DSS_EXPORT_WITH_DES40_CBC_SHA",
+			keyExchange:    "DHE",
+			authentication: "DSS",
+		},
+		"anonymous": {
+			cipherSuite:    "TLS_DH_anon_WITH_AES_128_CBC_SHA",
+			keyExchange:    "DH",
+			authentication: "anon",
+		},
+		"PSK": {
+			cipherSuite:    "TLS_PSK_WITH_AES_128_GCM_SHA256",
+			keyExchange:    "PSK",
+			authentication: "PSK",
+		},
+		"PSK with ephemeral DH": {
+			cipherSuite:    "TLS_PSK_DHE_WITH_AES_128_CCM_8",
+			keyExchange:    "DHE",
+			authentication: "PSK",
+		},
+		"PSK with RSA certificate": {
+			cipherSuite:    "TLS_RSA_PSK_WITH_AES_128_GCM_SHA256",
+			keyExchange:    "RSA",
+			authentication: "RSA",
+		},
+		"SRP": {
+			cipherSuite:    "TLS_SRP_SHA_WITH_AES_128_CBC_SHA",
+			keyExchange:    "SRP",
+			authentication: "SRP",
+		},
+		"SRP with RSA certificate": {
+			cipherSuite:    "TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA",
+			keyExchange:    "SRP",
+			authentication: "RSA",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cs := ciphersuites.CipherSuite{Name: tt.cipherSuite}
+			if got := cs.KeyExchange(); got != tt.keyExchange {
+				t.Errorf("key exchange mismatch:\n  got:  %s\n  want: %s", got, tt.keyExchange)
+			}
+			if got := cs.Authentication(); got != tt.authentication {
+				t.Errorf("authentication mismatch:\n  got:  %s\n  want: %s", got, tt.authentication)
+			}
+		})
+	}
+}
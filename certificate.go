@@ -0,0 +1,175 @@
+package ciphersuites
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Compatibility describes which cipher suites can be served with a
+// certificate, as reported by [CheckCertificate].
+type Compatibility struct {
+	// KeyType is the type of the certificate's public key.
+	KeyType x509.PublicKeyAlgorithm
+
+	// Usable lists the cipher suites that can be served with the certificate,
+	// in the order they were given.
+	Usable []CipherSuite
+
+	// Unusable lists the cipher suites that require a different type of
+	// certificate, in the order they were given.
+	Unusable []IncompatibleSuite
+
+	// NoCertificate lists the cipher suites that do not authenticate the
+	// server with a certificate, such as anonymous and PSK cipher suites,
+	// and so are unaffected by it.
+	NoCertificate []CipherSuite
+}
+
+// IncompatibleSuite is a cipher suite that cannot be served with a
+// certificate, and the reason why.
+type IncompatibleSuite struct {
+	CipherSuite CipherSuite
+	Reason      string
+}
+
+// RecommendedRemaining reports whether any cipher suite classified as
+// [Recommended] can be served with the certificate.
+func (c Compatibility) RecommendedRemaining() bool {
+	for _, cs := range c.Usable {
+		if cs.IsRecommended() {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckCertificate reports which of the named cipher suites can be served
+// with cert, judged by the type of its public key and, if it restricts them,
+// its key usages. The names are IANA names such as those returned by
+// [ParseCipherList]; cipher suites that are not known are still checked by
+// name but reported as [Unknown].
+//
+// TLS 1.3 cipher suites can be served with RSA, ECDSA and Ed25519 keys. TLS
+// 1.2 cipher suites require the key named by [CipherSuite.Authentication],
+// except that ECDSA cipher suites can also be served with Ed25519 keys, as
+// described in RFC 8422.
+func CheckCertificate(cert *x509.Certificate, suites []string) Compatibility {
+	c := Compatibility{KeyType: cert.PublicKeyAlgorithm}
+	for _, name := range suites {
+		cs := lookup(name)
+		required, reason := certificateRequirement(cs, cert)
+		switch {
+		case !required:
+			c.NoCertificate = append(c.NoCertificate, cs)
+		case reason != "":
+			c.Unusable = append(c.Unusable, IncompatibleSuite{CipherSuite: cs, Reason: reason})
+		default:
+			c.Usable = append(c.Usable, cs)
+		}
+	}
+	return c
+}
+
+// certificateRequirement reports whether cs authenticates the server with a
+// certificate and, if so, why cert cannot be used to serve it. The reason is
+// empty if cert can be used.
+func certificateRequirement(cs CipherSuite, cert *x509.Certificate) (required bool, reason string) {
+	kex, auth := splitKeyExchange(cs.Name)
+	key := cert.PublicKeyAlgorithm
+
+	switch {
+	case auth == "":
+		switch key {
+		case x509.RSA, x509.ECDSA, x509.Ed25519:
+			return true, usageReason(cert, x509.KeyUsageDigitalSignature)
+		}
+		return true, "requires an RSA, ECDSA or Ed25519 key"
+	case kex == "DH" && (auth == "RSA" || auth == "DSS"):
+		return true, "requires a certificate with a static Diffie-Hellman key"
+	case kex == "ECDH" && (auth == "RSA" || auth == "ECDSA"):
+		if key != x509.ECDSA {
+			return true, "requires a certificate with a static elliptic curve Diffie-Hellman key"
+		}
+		return true, usageReason(cert, x509.KeyUsageKeyAgreement)
+	case auth == "RSA":
+		if key != x509.RSA {
+			return true, "requires an RSA key"
+		}
+		if kex == "RSA" {
+			return true, usageReason(cert, x509.KeyUsageKeyEncipherment)
+		}
+		return true, usageReason(cert, x509.KeyUsageDigitalSignature)
+	case auth == "ECDSA":
+		if key != x509.ECDSA && key != x509.Ed25519 {
+			return true, "requires an ECDSA or Ed25519 key"
+		}
+		return true, usageReason(cert, x509.KeyUsageDigitalSignature)
+	case auth == "DSS":
+		if key != x509.DSA {
+			return true, "requires a DSA key"
+		}
+		return true, usageReason(cert, x509.KeyUsageDigitalSignature)
+	case strings.HasPrefix(auth, "GOSTR"):
+		return true, "requires a GOST key"
+	default:
+		// Anonymous, PSK, SRP, Kerberos and password cipher suites do not
+		// use a certificate.
+		return false, ""
+	}
+}
+
+// usageReason returns why cert cannot be used for usage, or an empty string if
+// it can. Certificates without a key usage extension can be used for
+// anything.
+func usageReason(cert *x509.Certificate, usage x509.KeyUsage) string {
+	if cert.KeyUsage == 0 || cert.KeyUsage&usage != 0 {
+		return ""
+	}
+	switch usage {
+	case x509.KeyUsageKeyEncipherment:
+		return "the certificate's key usage does not permit key encipherment"
+	case x509.KeyUsageKeyAgreement:
+		return "the certificate's key usage does not permit key agreement"
+	default:
+		return "the certificate's key usage does not permit digital signatures"
+	}
+}
+
+// ParseCertificates parses every PEM encoded certificate in data, such as a
+// certificate chain with the leaf certificate first.
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d: %w", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM encoded certificates found")
+	}
+	return certs, nil
+}
+
+// LoadCertificateFile parses every PEM encoded certificate in the named file.
+func LoadCertificateFile(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCertificates(data)
+}
@@ -0,0 +1,142 @@
+package ciphersuites_test
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/internal/testcert"
+)
+
+var certificateSuites = []string{
+	"TLS_AES_128_GCM_SHA256",
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256",
+	"TLS_DH_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_PSK_WITH_AES_128_GCM_SHA256",
+}
+
+func TestCheckCertificate(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		key           crypto.Signer
+		keyUsage      x509.KeyUsage
+		usable        []string
+		noCertificate []string
+		recommended   bool
+	}{
+		"RSA": {
+			key: testcert.RSAKey(),
+			usable: []string{
+				"TLS_AES_128_GCM_SHA256",
+				"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+				"TLS_RSA_WITH_AES_128_GCM_SHA256",
+			},
+			noCertificate: []string{"TLS_PSK_WITH_AES_128_GCM_SHA256"},
+			recommended:   true,
+		},
+		"RSA restricted to signatures": {
+			key:      testcert.RSAKey(),
+			keyUsage: x509.KeyUsageDigitalSignature,
+			usable: []string{
+				"TLS_AES_128_GCM_SHA256",
+				"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+			},
+			noCertificate: []string{"TLS_PSK_WITH_AES_128_GCM_SHA256"},
+			recommended:   true,
+		},
+		"ECDSA": {
+			key: testcert.ECDSAKey(),
+			usable: []string{
+				"TLS_AES_128_GCM_SHA256",
+				"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+				"TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256",
+			},
+			noCertificate: []string{"TLS_PSK_WITH_AES_128_GCM_SHA256"},
+			recommended:   true,
+		},
+		"Ed25519": {
+			key: testcert.Ed25519Key(),
+			usable: []string{
+				"TLS_AES_128_GCM_SHA256",
+				"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+			},
+			noCertificate: []string{"TLS_PSK_WITH_AES_128_GCM_SHA256"},
+			recommended:   true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cert := testcert.SelfSigned(tt.key, tt.keyUsage)
+			c := ciphersuites.CheckCertificate(cert, certificateSuites)
+
+			if got := suiteNames(c.Usable); !reflect.DeepEqual(got, tt.usable) {
+				t.Errorf("usable mismatch:\n  got:  %v\n  want: %v", got, tt.usable)
+			}
+			if got := suiteNames(c.NoCertificate); !reflect.DeepEqual(got, tt.noCertificate) {
+				t.Errorf("no certificate mismatch:\n  got:  %v\n  want: %v", got, tt.noCertificate)
+			}
+			if got, want := len(c.Usable)+len(c.Unusable)+len(c.NoCertificate), len(certificateSuites); got != want {
+				t.Errorf("count mismatch:\n  got:  %d\n  want: %d", got, want)
+			}
+			for _, u := range c.Unusable {
+				if u.Reason == "" {
+					t.Errorf("no reason given for %s", u.CipherSuite.Name)
+				}
+			}
+			if got := c.RecommendedRemaining(); got != tt.recommended {
+				t.Errorf("recommended mismatch:\n  got:  %t\n  want: %t", got, tt.recommended)
+			}
+		})
+	}
+}
+
+func TestCheckCertificateNoRecommendedRemaining(t *testing.T) {
+	t.Parallel()
+
+	cert := testcert.SelfSigned(testcert.ECDSAKey(), 0)
+	c := ciphersuites.CheckCertificate(cert, []string{
+		"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	})
+
+	if len(c.Usable) != 0 {
+		t.Errorf("usable mismatch:\n  got:  %v\n  want: []", suiteNames(c.Usable))
+	}
+	if c.RecommendedRemaining() {
+		t.Error("expected no recommended cipher suite to remain")
+	}
+}
+
+func TestParseCertificates(t *testing.T) {
+	t.Parallel()
+
+	leaf := testcert.SelfSigned(testcert.ECDSAKey(), 0)
+	issuer := testcert.SelfSigned(testcert.Ed25519Key(), 0)
+
+	var data []byte
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})...)
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("ignored")})...)
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: issuer.Raw})...)
+
+	certs, err := ciphersuites.ParseCertificates(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(certs) != 2 || !certs[0].Equal(leaf) || !certs[1].Equal(issuer) {
+		t.Errorf("mismatch:\n  got:  %d certificates\n  want: leaf and issuer", len(certs))
+	}
+
+	if _, err := ciphersuites.ParseCertificates([]byte("not a certificate")); err == nil {
+		t.Error("expected an error for data without certificates")
+	}
+}
@@ -1,378 +1,5653 @@
 // Code generated by cipher suite generator. DO NOT EDIT.
-// Generated at: 2026-01-30T22:18:24Z
-// Source: https://www.iana.org/assignments/tls-parameters/tls-parameters-4.csv
+// Generated at: 2026-10-19T02:05:38Z
+// Source: testdata/registry/2026-01-30.csv
+// SHA-256: eeccb9f4816ef7ce7fafb7f4782320c9c16e1bff10e54dcee0d6c539a829cd8a
 
 package ciphersuites
 
+import "time"
+
+// datasetSource is the URL the registry was fetched from, or the path of the
+// local copy it was read from.
+const datasetSource = "testdata/registry/2026-01-30.csv"
+
+// datasetChecksum is the hex-encoded SHA-256 digest of the registry file, or
+// empty if it was not recorded.
+const datasetChecksum = "eeccb9f4816ef7ce7fafb7f4782320c9c16e1bff10e54dcee0d6c539a829cd8a"
+
 // RecommendedCipherSuites is a list of secure cipher suites recommended for
 // use.
 var RecommendedCipherSuites = map[string]CipherSuite{
-	"TLS_AES_128_CCM_SHA256":                        {"TLS", "AES 128 CCM", "SHA256", Recommended, []string{"TLS1.3"}},
-	"TLS_AES_128_GCM_SHA256":                        {"TLS", "AES 128 GCM", "SHA256", Recommended, []string{"TLS1.3"}},
-	"TLS_AES_256_GCM_SHA384":                        {"TLS", "AES 256 GCM", "SHA384", Recommended, []string{"TLS1.3"}},
-	"TLS_CHACHA20_POLY1305_SHA256":                  {"TLS", "CHACHA20 POLY1305", "SHA256", Recommended, []string{"TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256":       {"TLS", "AES 128 GCM", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384":       {"TLS", "AES 256 GCM", "SHA384", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256": {"TLS", "CHACHA20 POLY1305", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_128_CCM_SHA256":         {"TLS", "AES 128 CCM", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256":   {"TLS", "CHACHA20 POLY1305", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Recommended, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256":   {"TLS", "CHACHA20 POLY1305", "SHA256", Recommended, []string{"TLS1.2", "TLS1.3"}},
+	"TLS_AES_128_CCM_SHA256": {
+		ID:                  0x1304,
+		Name:                "TLS_AES_128_CCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_AES_128_GCM_SHA256": {
+		ID:                  0x1301,
+		Name:                "TLS_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_AES_256_GCM_SHA384": {
+		ID:                  0x1302,
+		Name:                "TLS_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_CHACHA20_POLY1305_SHA256": {
+		ID:                  0x1303,
+		Name:                "TLS_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0xC02B,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0xC02C,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCA9,
+		Name:                "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_128_CCM_SHA256": {
+		ID:                  0xD005,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_128_CCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_128_GCM_SHA256": {
+		ID:                  0xD001,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_256_GCM_SHA384": {
+		ID:                  0xD002,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCAC,
+		Name:                "TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0xC02F,
+		Name:                "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0xC030,
+		Name:                "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCA8,
+		Name:                "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Recommended,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
 }
 
 // SecureCipherSuites is a list of secure cipher suites.
 var SecureCipherSuites = map[string]CipherSuite{
-	"TLS_AEGIS_128L_SHA256":                        {"TLS", "AEGIS 128L", "SHA256", Secure, []string{"TLS1.3"}},
-	"TLS_AEGIS_256_SHA512":                         {"TLS", "AEGIS 256", "SHA512", Secure, []string{"TLS1.3"}},
-	"TLS_AES_128_CCM_8_SHA256":                     {"TLS", "AES 128 CCM 8", "SHA256", Secure, []string{"TLS1.3"}},
-	"TLS_AES_128_CCM_ASCONHASH256":                 {"TLS", "AES 128 CCM", "ASCONHASH256", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_AES_128_GCM_ASCONHASH256":                 {"TLS", "AES 128 GCM", "ASCONHASH256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ASCONAEAD128_ASCONHASH256":                {"TLS", "ASCONAEAD128", "ASCONHASH256", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ASCONAEAD128_SHA256":                      {"TLS", "ASCONAEAD128", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECCPWD_WITH_AES_128_CCM_SHA256":           {"TLS", "AES 128 CCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECCPWD_WITH_AES_128_GCM_SHA256":           {"TLS", "AES 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECCPWD_WITH_AES_256_CCM_SHA384":           {"TLS", "AES 256 CCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECCPWD_WITH_AES_256_GCM_SHA384":           {"TLS", "AES 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_128_CCM":             {"TLS", "AES 128 CCM", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_128_CCM_8":           {"TLS", "AES 128 CCM 8", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_256_CCM":             {"TLS", "AES 256 CCM", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8":           {"TLS", "AES 256 CCM 8", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256":     {"TLS", "ARIA 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_ARIA_256_GCM_SHA384":     {"TLS", "ARIA 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_GCM_SHA256": {"TLS", "CAMELLIA 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_GCM_SHA384": {"TLS", "CAMELLIA 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_128_CCM_8_SHA256":      {"TLS", "AES 128 CCM 8", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_ARIA_128_GCM_SHA256":       {"TLS", "ARIA 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_ARIA_256_GCM_SHA384":       {"TLS", "ARIA 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_CAMELLIA_128_GCM_SHA256":   {"TLS", "CAMELLIA 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_CAMELLIA_256_GCM_SHA384":   {"TLS", "CAMELLIA 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_EMPTY_RENEGOTIATION_INFO_SCSV":            {"TLS", "EMPTY RENEGOTIATION INFO", "SCSV", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_FALLBACK_SCSV":                            {"TLS", "FALLBACK", "SCSV", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_28147_CNT_IMIT":      {"TLS", "28147 CNT IMIT", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_KUZNYECHIK_CTR_OMAC": {"TLS", "KUZNYECHIK CTR OMAC", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_L":    {"TLS", "KUZNYECHIK MGM L", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_S":    {"TLS", "KUZNYECHIK MGM S", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_MAGMA_CTR_OMAC":      {"TLS", "MAGMA CTR OMAC", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_MAGMA_MGM_L":         {"TLS", "MAGMA MGM L", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_GOSTR341112_256_WITH_MAGMA_MGM_S":         {"TLS", "MAGMA MGM S", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_128_CCM":                     {"TLS", "AES 128 CCM", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_128_CCM_8":                   {"TLS", "AES 128 CCM 8", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_128_GCM_SHA256":              {"TLS", "AES 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_256_CCM":                     {"TLS", "AES 256 CCM", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_256_CCM_8":                   {"TLS", "AES 256 CCM 8", "", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_256_GCM_SHA384":              {"TLS", "AES 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_ARIA_128_GCM_SHA256":             {"TLS", "ARIA 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_ARIA_256_GCM_SHA384":             {"TLS", "ARIA 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_CAMELLIA_128_GCM_SHA256":         {"TLS", "CAMELLIA 128 GCM", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_CAMELLIA_256_GCM_SHA384":         {"TLS", "CAMELLIA 256 GCM", "SHA384", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_CHACHA20_POLY1305_SHA256":        {"TLS", "CHACHA20 POLY1305", "SHA256", Secure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_SM4_CCM_SM3":                              {"TLS", "SM4 CCM", "SM3", Secure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SM4_GCM_SM3":                              {"TLS", "SM4 GCM", "SM3", Secure, []string{"TLS1.2", "TLS1.3"}},
+	"TLS_AEGIS_128L_SHA256": {
+		ID:                  0x1307,
+		Name:                "TLS_AEGIS_128L_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AEGIS 128L",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_AEGIS_256_SHA512": {
+		ID:                  0x1306,
+		Name:                "TLS_AEGIS_256_SHA512",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AEGIS 256",
+		HashAlgorithm:       "SHA512",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_AES_128_CCM_8_SHA256": {
+		ID:                  0x1305,
+		Name:                "TLS_AES_128_CCM_8_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_AES_128_CCM_ASCONHASH256": {
+		ID:                  0x130B,
+		Name:                "TLS_AES_128_CCM_ASCONHASH256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "ASCONHASH256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_AES_128_GCM_ASCONHASH256": {
+		ID:                  0x130A,
+		Name:                "TLS_AES_128_GCM_ASCONHASH256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "ASCONHASH256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_ASCONAEAD128_ASCONHASH256": {
+		ID:                  0x1309,
+		Name:                "TLS_ASCONAEAD128_ASCONHASH256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ASCONAEAD128",
+		HashAlgorithm:       "ASCONHASH256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_ASCONAEAD128_SHA256": {
+		ID:                  0x1308,
+		Name:                "TLS_ASCONAEAD128_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ASCONAEAD128",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.3"},
+	},
+	"TLS_ECCPWD_WITH_AES_128_CCM_SHA256": {
+		ID:                  0xC0B2,
+		Name:                "TLS_ECCPWD_WITH_AES_128_CCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECCPWD_WITH_AES_128_GCM_SHA256": {
+		ID:                  0xC0B0,
+		Name:                "TLS_ECCPWD_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECCPWD_WITH_AES_256_CCM_SHA384": {
+		ID:                  0xC0B3,
+		Name:                "TLS_ECCPWD_WITH_AES_256_CCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECCPWD_WITH_AES_256_GCM_SHA384": {
+		ID:                  0xC0B1,
+		Name:                "TLS_ECCPWD_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CCM": {
+		ID:                  0xC0AC,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_128_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CCM_8": {
+		ID:                  0xC0AE,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_128_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CCM": {
+		ID:                  0xC0AD,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_256_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8": {
+		ID:                  0xC0AF,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC05C,
+		Name:                "TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC05D,
+		Name:                "TLS_ECDHE_ECDSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC086,
+		Name:                "TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC087,
+		Name:                "TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_128_CCM_8_SHA256": {
+		ID:                  0xD003,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_128_CCM_8_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC060,
+		Name:                "TLS_ECDHE_RSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC061,
+		Name:                "TLS_ECDHE_RSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC08A,
+		Name:                "TLS_ECDHE_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC08B,
+		Name:                "TLS_ECDHE_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_EMPTY_RENEGOTIATION_INFO_SCSV": {
+		ID:                  0x00FF,
+		Name:                "TLS_EMPTY_RENEGOTIATION_INFO_SCSV",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "EMPTY RENEGOTIATION INFO",
+		HashAlgorithm:       "SCSV",
+		Classification:      Secure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_FALLBACK_SCSV": {
+		ID:                  0x5600,
+		Name:                "TLS_FALLBACK_SCSV",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "FALLBACK",
+		HashAlgorithm:       "SCSV",
+		Classification:      Secure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_28147_CNT_IMIT": {
+		ID:                  0xC102,
+		Name:                "TLS_GOSTR341112_256_WITH_28147_CNT_IMIT",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "28147 CNT IMIT",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_KUZNYECHIK_CTR_OMAC": {
+		ID:                  0xC100,
+		Name:                "TLS_GOSTR341112_256_WITH_KUZNYECHIK_CTR_OMAC",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "KUZNYECHIK CTR OMAC",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_L": {
+		ID:                  0xC103,
+		Name:                "TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_L",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "KUZNYECHIK MGM L",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_S": {
+		ID:                  0xC105,
+		Name:                "TLS_GOSTR341112_256_WITH_KUZNYECHIK_MGM_S",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "KUZNYECHIK MGM S",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_MAGMA_CTR_OMAC": {
+		ID:                  0xC101,
+		Name:                "TLS_GOSTR341112_256_WITH_MAGMA_CTR_OMAC",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "MAGMA CTR OMAC",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_MAGMA_MGM_L": {
+		ID:                  0xC104,
+		Name:                "TLS_GOSTR341112_256_WITH_MAGMA_MGM_L",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "MAGMA MGM L",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_GOSTR341112_256_WITH_MAGMA_MGM_S": {
+		ID:                  0xC106,
+		Name:                "TLS_GOSTR341112_256_WITH_MAGMA_MGM_S",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "MAGMA MGM S",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_128_CCM": {
+		ID:                  0xC0A4,
+		Name:                "TLS_PSK_WITH_AES_128_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_128_CCM_8": {
+		ID:                  0xC0A8,
+		Name:                "TLS_PSK_WITH_AES_128_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00A8,
+		Name:                "TLS_PSK_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_256_CCM": {
+		ID:                  0xC0A5,
+		Name:                "TLS_PSK_WITH_AES_256_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_256_CCM_8": {
+		ID:                  0xC0A9,
+		Name:                "TLS_PSK_WITH_AES_256_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Secure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00A9,
+		Name:                "TLS_PSK_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC06A,
+		Name:                "TLS_PSK_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC06B,
+		Name:                "TLS_PSK_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC08E,
+		Name:                "TLS_PSK_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC08F,
+		Name:                "TLS_PSK_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCAB,
+		Name:                "TLS_PSK_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Secure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_SM4_CCM_SM3": {
+		ID:                  0x00C7,
+		Name:                "TLS_SM4_CCM_SM3",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SM4 CCM",
+		HashAlgorithm:       "SM3",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_SM4_GCM_SM3": {
+		ID:                  0x00C6,
+		Name:                "TLS_SM4_GCM_SM3",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SM4 GCM",
+		HashAlgorithm:       "SM3",
+		Classification:      Secure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
 }
 
 // WeakCipherSuites is a list of weak cipher suites.
 var WeakCipherSuites = map[string]CipherSuite{
-	"TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA":         {"TLS", "CAMELLIA 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA":        {"TLS", "CAMELLIA 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_3DES_EDE_CBC_SHA":        {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA":         {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256":      {"TLS", "AES 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA":         {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384":      {"TLS", "AES 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_ARIA_128_CBC_SHA256":     {"TLS", "ARIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_ARIA_256_CBC_SHA384":     {"TLS", "ARIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_CBC_SHA256": {"TLS", "CAMELLIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_CBC_SHA384": {"TLS", "CAMELLIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_3DES_EDE_CBC_SHA":          {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA":           {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA256":        {"TLS", "AES 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA":           {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA384":        {"TLS", "AES 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_ARIA_128_CBC_SHA256":       {"TLS", "ARIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_ARIA_256_CBC_SHA384":       {"TLS", "ARIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_CAMELLIA_128_CBC_SHA256":   {"TLS", "CAMELLIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_CAMELLIA_256_CBC_SHA384":   {"TLS", "CAMELLIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA":          {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA":           {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256":        {"TLS", "AES 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA":           {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384":        {"TLS", "AES 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_ARIA_128_CBC_SHA256":       {"TLS", "ARIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_ARIA_256_CBC_SHA384":       {"TLS", "ARIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_CAMELLIA_128_CBC_SHA256":   {"TLS", "CAMELLIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_CAMELLIA_256_CBC_SHA384":   {"TLS", "CAMELLIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA":          {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA":          {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_3DES_EDE_CBC_SHA":               {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_3DES_EDE_CBC_SHA":                {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_128_CBC_SHA":                 {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_128_CBC_SHA256":              {"TLS", "AES 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_256_CBC_SHA":                 {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_AES_256_CBC_SHA384":              {"TLS", "AES 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_ARIA_128_CBC_SHA256":             {"TLS", "ARIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_ARIA_256_CBC_SHA384":             {"TLS", "ARIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_CAMELLIA_128_CBC_SHA256":         {"TLS", "CAMELLIA 128 CBC", "SHA256", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_CAMELLIA_256_CBC_SHA384":         {"TLS", "CAMELLIA 256 CBC", "SHA384", Weak, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_DSS_WITH_3DES_EDE_CBC_SHA":        {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_DSS_WITH_AES_128_CBC_SHA":         {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_DSS_WITH_AES_256_CBC_SHA":         {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_RSA_WITH_3DES_EDE_CBC_SHA":        {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA":         {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_RSA_WITH_AES_256_CBC_SHA":         {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_WITH_3DES_EDE_CBC_SHA":            {"TLS", "3DES EDE CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_WITH_AES_128_CBC_SHA":             {"TLS", "AES 128 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SRP_SHA_WITH_AES_256_CBC_SHA":             {"TLS", "AES 256 CBC", "SHA", Weak, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
+	"TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA": {
+		ID:                  0x0086,
+		Name:                "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA": {
+		ID:                  0x0089,
+		Name:                "TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_ECDSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC008,
+		Name:                "TLS_ECDHE_ECDSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC009,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0xC023,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC00A,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384": {
+		ID:                  0xC024,
+		Name:                "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC048,
+		Name:                "TLS_ECDHE_ECDSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC049,
+		Name:                "TLS_ECDHE_ECDSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC072,
+		Name:                "TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC073,
+		Name:                "TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC034,
+		Name:                "TLS_ECDHE_PSK_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC035,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA256": {
+		ID:                  0xC037,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC036,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA384": {
+		ID:                  0xC038,
+		Name:                "TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC070,
+		Name:                "TLS_ECDHE_PSK_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC071,
+		Name:                "TLS_ECDHE_PSK_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC09A,
+		Name:                "TLS_ECDHE_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_PSK_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC09B,
+		Name:                "TLS_ECDHE_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC012,
+		Name:                "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC013,
+		Name:                "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0xC027,
+		Name:                "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC014,
+		Name:                "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384": {
+		ID:                  0xC028,
+		Name:                "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC04C,
+		Name:                "TLS_ECDHE_RSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC04D,
+		Name:                "TLS_ECDHE_RSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC076,
+		Name:                "TLS_ECDHE_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDHE_RSA_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC077,
+		Name:                "TLS_ECDHE_RSA_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC004,
+		Name:                "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC005,
+		Name:                "TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x001F,
+		Name:                "TLS_KRB5_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_PSK_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x008B,
+		Name:                "TLS_PSK_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_PSK_WITH_AES_128_CBC_SHA": {
+		ID:                  0x008C,
+		Name:                "TLS_PSK_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_PSK_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x00AE,
+		Name:                "TLS_PSK_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_AES_256_CBC_SHA": {
+		ID:                  0x008D,
+		Name:                "TLS_PSK_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_PSK_WITH_AES_256_CBC_SHA384": {
+		ID:                  0x00AF,
+		Name:                "TLS_PSK_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC064,
+		Name:                "TLS_PSK_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC065,
+		Name:                "TLS_PSK_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC094,
+		Name:                "TLS_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC095,
+		Name:                "TLS_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_SRP_SHA_DSS_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC01C,
+		Name:                "TLS_SRP_SHA_DSS_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_DSS_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC01F,
+		Name:                "TLS_SRP_SHA_DSS_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_DSS_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC022,
+		Name:                "TLS_SRP_SHA_DSS_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_RSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC01B,
+		Name:                "TLS_SRP_SHA_RSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC01E,
+		Name:                "TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_RSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC021,
+		Name:                "TLS_SRP_SHA_RSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC01A,
+		Name:                "TLS_SRP_SHA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC01D,
+		Name:                "TLS_SRP_SHA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SRP_SHA_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC020,
+		Name:                "TLS_SRP_SHA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Weak,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
 }
 
 // InsecureCipherSuites is a list of insecure cipher suites.
 var InsecureCipherSuites = map[string]CipherSuite{
-	"TLS_DHE_DSS_EXPORT_WITH_DES40_CBC_SHA":       {"TLS EXPORT", "DES40 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_3DES_EDE_CBC_SHA":           {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_AES_128_CBC_SHA":            {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_AES_128_CBC_SHA256":         {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_AES_256_CBC_SHA":            {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_AES_256_CBC_SHA256":         {"TLS", "AES 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_ARIA_128_CBC_SHA256":        {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_ARIA_128_GCM_SHA256":        {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_ARIA_256_CBC_SHA384":        {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_ARIA_256_GCM_SHA384":        {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA":       {"TLS", "CAMELLIA 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA256":    {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_CAMELLIA_128_GCM_SHA256":    {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA":       {"TLS", "CAMELLIA 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA256":    {"TLS", "CAMELLIA 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_CAMELLIA_256_GCM_SHA384":    {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_DES_CBC_SHA":                {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_DSS_WITH_SEED_CBC_SHA":               {"TLS", "SEED CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_3DES_EDE_CBC_SHA":           {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_128_CBC_SHA":            {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_128_CBC_SHA256":         {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_128_CCM":                {"TLS", "AES 128 CCM", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_256_CBC_SHA":            {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_256_CBC_SHA384":         {"TLS", "AES 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_256_CCM":                {"TLS", "AES 256 CCM", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_ARIA_128_CBC_SHA256":        {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_ARIA_128_GCM_SHA256":        {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_ARIA_256_CBC_SHA384":        {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_ARIA_256_GCM_SHA384":        {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_CAMELLIA_128_CBC_SHA256":    {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_CAMELLIA_128_GCM_SHA256":    {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_CAMELLIA_256_CBC_SHA384":    {"TLS", "CAMELLIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_CAMELLIA_256_GCM_SHA384":    {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_CHACHA20_POLY1305_SHA256":   {"TLS", "CHACHA20 POLY1305", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_NULL_SHA":                   {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_NULL_SHA256":                {"TLS", "NULL", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_NULL_SHA384":                {"TLS", "NULL", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_PSK_WITH_RC4_128_SHA":                {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA":       {"TLS EXPORT", "DES40 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA":           {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_128_CBC_SHA":            {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_128_CBC_SHA256":         {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_128_CCM":                {"TLS", "AES 128 CCM", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_128_CCM_8":              {"TLS", "AES 128 CCM 8", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_256_CBC_SHA":            {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_256_CBC_SHA256":         {"TLS", "AES 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_256_CCM":                {"TLS", "AES 256 CCM", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_256_CCM_8":              {"TLS", "AES 256 CCM 8", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_ARIA_128_CBC_SHA256":        {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_ARIA_128_GCM_SHA256":        {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_ARIA_256_CBC_SHA384":        {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_ARIA_256_GCM_SHA384":        {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA":       {"TLS", "CAMELLIA 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA256":    {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CAMELLIA_128_GCM_SHA256":    {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA":       {"TLS", "CAMELLIA 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA256":    {"TLS", "CAMELLIA 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CAMELLIA_256_GCM_SHA384":    {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256":   {"TLS", "CHACHA20 POLY1305", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_DES_CBC_SHA":                {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DHE_RSA_WITH_SEED_CBC_SHA":               {"TLS", "SEED CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_EXPORT_WITH_DES40_CBC_SHA":        {"TLS EXPORT", "DES40 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_3DES_EDE_CBC_SHA":            {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_AES_128_CBC_SHA":             {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_AES_128_CBC_SHA256":          {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_AES_128_GCM_SHA256":          {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_AES_256_CBC_SHA":             {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_AES_256_CBC_SHA256":          {"TLS", "AES 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_AES_256_GCM_SHA384":          {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_ARIA_128_CBC_SHA256":         {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_ARIA_128_GCM_SHA256":         {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_ARIA_256_CBC_SHA384":         {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_ARIA_256_GCM_SHA384":         {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA":        {"TLS", "CAMELLIA 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA256":     {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_CAMELLIA_128_GCM_SHA256":     {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA":        {"TLS", "CAMELLIA 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA256":     {"TLS", "CAMELLIA 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_CAMELLIA_256_GCM_SHA384":     {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_DES_CBC_SHA":                 {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_DSS_WITH_SEED_CBC_SHA":                {"TLS", "SEED CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_EXPORT_WITH_DES40_CBC_SHA":        {"TLS EXPORT", "DES40 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_3DES_EDE_CBC_SHA":            {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_AES_128_CBC_SHA":             {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_AES_128_CBC_SHA256":          {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_AES_128_GCM_SHA256":          {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_AES_256_CBC_SHA":             {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_AES_256_CBC_SHA256":          {"TLS", "AES 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_AES_256_GCM_SHA384":          {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_ARIA_128_CBC_SHA256":         {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_ARIA_128_GCM_SHA256":         {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_ARIA_256_CBC_SHA384":         {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_ARIA_256_GCM_SHA384":         {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA":        {"TLS", "CAMELLIA 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA256":     {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_CAMELLIA_128_GCM_SHA256":     {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA256":     {"TLS", "CAMELLIA 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_CAMELLIA_256_GCM_SHA384":     {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_DES_CBC_SHA":                 {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_RSA_WITH_SEED_CBC_SHA":                {"TLS", "SEED CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_EXPORT_WITH_DES40_CBC_SHA":       {"TLS EXPORT", "DES40 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_EXPORT_WITH_RC4_40_MD5":          {"TLS EXPORT", "RC4 40", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_3DES_EDE_CBC_SHA":           {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_AES_128_CBC_SHA":            {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_AES_128_CBC_SHA256":         {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_AES_256_CBC_SHA":            {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_AES_256_CBC_SHA256":         {"TLS", "AES 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_ARIA_128_CBC_SHA256":        {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_ARIA_128_GCM_SHA256":        {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_ARIA_256_CBC_SHA384":        {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_ARIA_256_GCM_SHA384":        {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA":       {"TLS", "CAMELLIA 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA256":    {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_CAMELLIA_128_GCM_SHA256":    {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA256":    {"TLS", "CAMELLIA 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_CAMELLIA_256_GCM_SHA384":    {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_DES_CBC_SHA":                {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_RC4_128_MD5":                {"TLS", "RC4 128", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_DH_anon_WITH_SEED_CBC_SHA":               {"TLS", "SEED CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_NULL_SHA":               {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_ECDSA_WITH_RC4_128_SHA":            {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_NULL_SHA":                 {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_NULL_SHA256":              {"TLS", "NULL", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_NULL_SHA384":              {"TLS", "NULL", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_PSK_WITH_RC4_128_SHA":              {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_NULL_SHA":                 {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDHE_RSA_WITH_RC4_128_SHA":              {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_3DES_EDE_CBC_SHA":        {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA256":      {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256":      {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA384":      {"TLS", "AES 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_AES_256_GCM_SHA384":      {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_ARIA_128_CBC_SHA256":     {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_ARIA_128_GCM_SHA256":     {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_ARIA_256_CBC_SHA384":     {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_ARIA_256_GCM_SHA384":     {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_CAMELLIA_128_CBC_SHA256": {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_CAMELLIA_128_GCM_SHA256": {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_CAMELLIA_256_CBC_SHA384": {"TLS", "CAMELLIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_CAMELLIA_256_GCM_SHA384": {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_NULL_SHA":                {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_ECDSA_WITH_RC4_128_SHA":             {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_3DES_EDE_CBC_SHA":          {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_AES_128_CBC_SHA":           {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_AES_128_CBC_SHA256":        {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256":        {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_AES_256_CBC_SHA":           {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_AES_256_CBC_SHA384":        {"TLS", "AES 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_AES_256_GCM_SHA384":        {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_ARIA_128_CBC_SHA256":       {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_ARIA_128_GCM_SHA256":       {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_ARIA_256_CBC_SHA384":       {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_ARIA_256_GCM_SHA384":       {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_CAMELLIA_128_CBC_SHA256":   {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_CAMELLIA_128_GCM_SHA256":   {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_CAMELLIA_256_CBC_SHA384":   {"TLS", "CAMELLIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_CAMELLIA_256_GCM_SHA384":   {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_NULL_SHA":                  {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_RSA_WITH_RC4_128_SHA":               {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_anon_WITH_3DES_EDE_CBC_SHA":         {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_anon_WITH_AES_128_CBC_SHA":          {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_anon_WITH_AES_256_CBC_SHA":          {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_anon_WITH_NULL_SHA":                 {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_ECDH_anon_WITH_RC4_128_SHA":              {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_EXPORT_WITH_DES_CBC_40_MD5":         {"TLS EXPORT", "DES CBC 40", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_EXPORT_WITH_DES_CBC_40_SHA":         {"TLS EXPORT", "DES CBC 40", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_EXPORT_WITH_RC2_CBC_40_MD5":         {"TLS EXPORT", "RC2 CBC 40", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_EXPORT_WITH_RC2_CBC_40_SHA":         {"TLS EXPORT", "RC2 CBC 40", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_EXPORT_WITH_RC4_40_MD5":             {"TLS EXPORT", "RC4 40", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_EXPORT_WITH_RC4_40_SHA":             {"TLS EXPORT", "RC4 40", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_3DES_EDE_CBC_MD5":              {"TLS", "3DES EDE CBC", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_DES_CBC_MD5":                   {"TLS", "DES CBC", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_DES_CBC_SHA":                   {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_IDEA_CBC_MD5":                  {"TLS", "IDEA CBC", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_IDEA_CBC_SHA":                  {"TLS", "IDEA CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_RC4_128_MD5":                   {"TLS", "RC4 128", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_KRB5_WITH_RC4_128_SHA":                   {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_NULL_WITH_NULL_NULL":                     {"TLS", "NULL NULL", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_DHE_WITH_AES_128_CCM_8":              {"TLS", "AES 128 CCM 8", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_DHE_WITH_AES_256_CCM_8":              {"TLS", "AES 256 CCM 8", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_NULL_SHA":                       {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_NULL_SHA256":                    {"TLS", "NULL", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_NULL_SHA384":                    {"TLS", "NULL", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_PSK_WITH_RC4_128_SHA":                    {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_EXPORT_WITH_DES40_CBC_SHA":           {"TLS EXPORT", "DES40 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_EXPORT_WITH_RC2_CBC_40_MD5":          {"TLS EXPORT", "RC2 CBC 40", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_EXPORT_WITH_RC4_40_MD5":              {"TLS EXPORT", "RC4 40", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_3DES_EDE_CBC_SHA":           {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_AES_128_CBC_SHA":            {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_AES_128_CBC_SHA256":         {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_AES_128_GCM_SHA256":         {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_AES_256_CBC_SHA":            {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_AES_256_CBC_SHA384":         {"TLS", "AES 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_AES_256_GCM_SHA384":         {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_ARIA_128_CBC_SHA256":        {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_ARIA_128_GCM_SHA256":        {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_ARIA_256_CBC_SHA384":        {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_ARIA_256_GCM_SHA384":        {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_CAMELLIA_128_CBC_SHA256":    {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_CAMELLIA_128_GCM_SHA256":    {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_CAMELLIA_256_CBC_SHA384":    {"TLS", "CAMELLIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_CAMELLIA_256_GCM_SHA384":    {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_CHACHA20_POLY1305_SHA256":   {"TLS", "CHACHA20 POLY1305", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_NULL_SHA":                   {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_NULL_SHA256":                {"TLS", "NULL", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_NULL_SHA384":                {"TLS", "NULL", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_PSK_WITH_RC4_128_SHA":                {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_3DES_EDE_CBC_SHA":               {"TLS", "3DES EDE CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_128_CBC_SHA":                {"TLS", "AES 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_128_CBC_SHA256":             {"TLS", "AES 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_128_CCM":                    {"TLS", "AES 128 CCM", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_128_CCM_8":                  {"TLS", "AES 128 CCM 8", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_128_GCM_SHA256":             {"TLS", "AES 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_256_CBC_SHA":                {"TLS", "AES 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_256_CBC_SHA256":             {"TLS", "AES 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_256_CCM":                    {"TLS", "AES 256 CCM", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_256_CCM_8":                  {"TLS", "AES 256 CCM 8", "", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_AES_256_GCM_SHA384":             {"TLS", "AES 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_ARIA_128_CBC_SHA256":            {"TLS", "ARIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_ARIA_128_GCM_SHA256":            {"TLS", "ARIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_ARIA_256_CBC_SHA384":            {"TLS", "ARIA 256 CBC", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_ARIA_256_GCM_SHA384":            {"TLS", "ARIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_CAMELLIA_128_CBC_SHA":           {"TLS", "CAMELLIA 128 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_CAMELLIA_128_CBC_SHA256":        {"TLS", "CAMELLIA 128 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_CAMELLIA_128_GCM_SHA256":        {"TLS", "CAMELLIA 128 GCM", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_CAMELLIA_256_CBC_SHA":           {"TLS", "CAMELLIA 256 CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_CAMELLIA_256_CBC_SHA256":        {"TLS", "CAMELLIA 256 CBC", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_CAMELLIA_256_GCM_SHA384":        {"TLS", "CAMELLIA 256 GCM", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_DES_CBC_SHA":                    {"TLS", "DES CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_IDEA_CBC_SHA":                   {"TLS", "IDEA CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_NULL_MD5":                       {"TLS", "NULL", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_NULL_SHA":                       {"TLS", "NULL", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_NULL_SHA256":                    {"TLS", "NULL", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_RC4_128_MD5":                    {"TLS", "RC4 128", "MD5", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_RC4_128_SHA":                    {"TLS", "RC4 128", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_RSA_WITH_SEED_CBC_SHA":                   {"TLS", "SEED CBC", "SHA", Insecure, []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"}},
-	"TLS_SHA256_SHA256":                           {"TLS", "SHA256", "SHA256", Insecure, []string{"TLS1.2", "TLS1.3"}},
-	"TLS_SHA384_SHA384":                           {"TLS", "SHA384", "SHA384", Insecure, []string{"TLS1.2", "TLS1.3"}},
+	"TLS_DHE_DSS_EXPORT_WITH_DES40_CBC_SHA": {
+		ID:                  0x0011,
+		Name:                "TLS_DHE_DSS_EXPORT_WITH_DES40_CBC_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES40 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x0013,
+		Name:                "TLS_DHE_DSS_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0032,
+		Name:                "TLS_DHE_DSS_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x0040,
+		Name:                "TLS_DHE_DSS_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00A2,
+		Name:                "TLS_DHE_DSS_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0038,
+		Name:                "TLS_DHE_DSS_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_AES_256_CBC_SHA256": {
+		ID:                  0x006A,
+		Name:                "TLS_DHE_DSS_WITH_AES_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00A3,
+		Name:                "TLS_DHE_DSS_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC042,
+		Name:                "TLS_DHE_DSS_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC056,
+		Name:                "TLS_DHE_DSS_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC043,
+		Name:                "TLS_DHE_DSS_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC057,
+		Name:                "TLS_DHE_DSS_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA": {
+		ID:                  0x0044,
+		Name:                "TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0x00BD,
+		Name:                "TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC080,
+		Name:                "TLS_DHE_DSS_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA": {
+		ID:                  0x0087,
+		Name:                "TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA256": {
+		ID:                  0x00C3,
+		Name:                "TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC081,
+		Name:                "TLS_DHE_DSS_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_DES_CBC_SHA": {
+		ID:                  0x0012,
+		Name:                "TLS_DHE_DSS_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_DSS_WITH_SEED_CBC_SHA": {
+		ID:                  0x0099,
+		Name:                "TLS_DHE_DSS_WITH_SEED_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SEED CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x008F,
+		Name:                "TLS_DHE_PSK_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0090,
+		Name:                "TLS_DHE_PSK_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x00B2,
+		Name:                "TLS_DHE_PSK_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_128_CCM": {
+		ID:                  0xC0A6,
+		Name:                "TLS_DHE_PSK_WITH_AES_128_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00AA,
+		Name:                "TLS_DHE_PSK_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0091,
+		Name:                "TLS_DHE_PSK_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_256_CBC_SHA384": {
+		ID:                  0x00B3,
+		Name:                "TLS_DHE_PSK_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_256_CCM": {
+		ID:                  0xC0A7,
+		Name:                "TLS_DHE_PSK_WITH_AES_256_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00AB,
+		Name:                "TLS_DHE_PSK_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC066,
+		Name:                "TLS_DHE_PSK_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC06C,
+		Name:                "TLS_DHE_PSK_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC067,
+		Name:                "TLS_DHE_PSK_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC06D,
+		Name:                "TLS_DHE_PSK_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC096,
+		Name:                "TLS_DHE_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC090,
+		Name:                "TLS_DHE_PSK_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC097,
+		Name:                "TLS_DHE_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC091,
+		Name:                "TLS_DHE_PSK_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCAD,
+		Name:                "TLS_DHE_PSK_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_NULL_SHA": {
+		ID:                  0x002D,
+		Name:                "TLS_DHE_PSK_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_NULL_SHA256": {
+		ID:                  0x00B4,
+		Name:                "TLS_DHE_PSK_WITH_NULL_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_NULL_SHA384": {
+		ID:                  0x00B5,
+		Name:                "TLS_DHE_PSK_WITH_NULL_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DHE_PSK_WITH_RC4_128_SHA": {
+		ID:                  0x008E,
+		Name:                "TLS_DHE_PSK_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA": {
+		ID:                  0x0014,
+		Name:                "TLS_DHE_RSA_EXPORT_WITH_DES40_CBC_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES40 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x0016,
+		Name:                "TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0033,
+		Name:                "TLS_DHE_RSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x0067,
+		Name:                "TLS_DHE_RSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_128_CCM": {
+		ID:                  0xC09E,
+		Name:                "TLS_DHE_RSA_WITH_AES_128_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_128_CCM_8": {
+		ID:                  0xC0A2,
+		Name:                "TLS_DHE_RSA_WITH_AES_128_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x009E,
+		Name:                "TLS_DHE_RSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0039,
+		Name:                "TLS_DHE_RSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_256_CBC_SHA256": {
+		ID:                  0x006B,
+		Name:                "TLS_DHE_RSA_WITH_AES_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_256_CCM": {
+		ID:                  0xC09F,
+		Name:                "TLS_DHE_RSA_WITH_AES_256_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_256_CCM_8": {
+		ID:                  0xC0A3,
+		Name:                "TLS_DHE_RSA_WITH_AES_256_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x009F,
+		Name:                "TLS_DHE_RSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC044,
+		Name:                "TLS_DHE_RSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC052,
+		Name:                "TLS_DHE_RSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC045,
+		Name:                "TLS_DHE_RSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC053,
+		Name:                "TLS_DHE_RSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA": {
+		ID:                  0x0045,
+		Name:                "TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0x00BE,
+		Name:                "TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC07C,
+		Name:                "TLS_DHE_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA": {
+		ID:                  0x0088,
+		Name:                "TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA256": {
+		ID:                  0x00C4,
+		Name:                "TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC07D,
+		Name:                "TLS_DHE_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCAA,
+		Name:                "TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_DES_CBC_SHA": {
+		ID:                  0x0015,
+		Name:                "TLS_DHE_RSA_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DHE_RSA_WITH_SEED_CBC_SHA": {
+		ID:                  0x009A,
+		Name:                "TLS_DHE_RSA_WITH_SEED_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SEED CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+				"Chrome 53 removes DHE cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_EXPORT_WITH_DES40_CBC_SHA": {
+		ID:                  0x000B,
+		Name:                "TLS_DH_DSS_EXPORT_WITH_DES40_CBC_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES40 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x000D,
+		Name:                "TLS_DH_DSS_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0030,
+		Name:                "TLS_DH_DSS_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x003E,
+		Name:                "TLS_DH_DSS_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00A4,
+		Name:                "TLS_DH_DSS_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0036,
+		Name:                "TLS_DH_DSS_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_AES_256_CBC_SHA256": {
+		ID:                  0x0068,
+		Name:                "TLS_DH_DSS_WITH_AES_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00A5,
+		Name:                "TLS_DH_DSS_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC03E,
+		Name:                "TLS_DH_DSS_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC058,
+		Name:                "TLS_DH_DSS_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC03F,
+		Name:                "TLS_DH_DSS_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC059,
+		Name:                "TLS_DH_DSS_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA": {
+		ID:                  0x0042,
+		Name:                "TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0x00BB,
+		Name:                "TLS_DH_DSS_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC082,
+		Name:                "TLS_DH_DSS_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA": {
+		ID:                  0x0085,
+		Name:                "TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA256": {
+		ID:                  0x00C1,
+		Name:                "TLS_DH_DSS_WITH_CAMELLIA_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC083,
+		Name:                "TLS_DH_DSS_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_DES_CBC_SHA": {
+		ID:                  0x000C,
+		Name:                "TLS_DH_DSS_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_DSS_WITH_SEED_CBC_SHA": {
+		ID:                  0x0097,
+		Name:                "TLS_DH_DSS_WITH_SEED_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SEED CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_EXPORT_WITH_DES40_CBC_SHA": {
+		ID:                  0x000E,
+		Name:                "TLS_DH_RSA_EXPORT_WITH_DES40_CBC_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES40 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x0010,
+		Name:                "TLS_DH_RSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0031,
+		Name:                "TLS_DH_RSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x003F,
+		Name:                "TLS_DH_RSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00A0,
+		Name:                "TLS_DH_RSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0037,
+		Name:                "TLS_DH_RSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_AES_256_CBC_SHA256": {
+		ID:                  0x0069,
+		Name:                "TLS_DH_RSA_WITH_AES_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00A1,
+		Name:                "TLS_DH_RSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC040,
+		Name:                "TLS_DH_RSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC054,
+		Name:                "TLS_DH_RSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC041,
+		Name:                "TLS_DH_RSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC055,
+		Name:                "TLS_DH_RSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA": {
+		ID:                  0x0043,
+		Name:                "TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0x00BC,
+		Name:                "TLS_DH_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC07E,
+		Name:                "TLS_DH_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA256": {
+		ID:                  0x00C2,
+		Name:                "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC07F,
+		Name:                "TLS_DH_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_DES_CBC_SHA": {
+		ID:                  0x000F,
+		Name:                "TLS_DH_RSA_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_RSA_WITH_SEED_CBC_SHA": {
+		ID:                  0x0098,
+		Name:                "TLS_DH_RSA_WITH_SEED_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SEED CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_EXPORT_WITH_DES40_CBC_SHA": {
+		ID:                  0x0019,
+		Name:                "TLS_DH_anon_EXPORT_WITH_DES40_CBC_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES40 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_EXPORT_WITH_RC4_40_MD5": {
+		ID:                  0x0017,
+		Name:                "TLS_DH_anon_EXPORT_WITH_RC4_40_MD5",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC4 40",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x001B,
+		Name:                "TLS_DH_anon_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0034,
+		Name:                "TLS_DH_anon_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x006C,
+		Name:                "TLS_DH_anon_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00A6,
+		Name:                "TLS_DH_anon_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_AES_256_CBC_SHA": {
+		ID:                  0x003A,
+		Name:                "TLS_DH_anon_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_AES_256_CBC_SHA256": {
+		ID:                  0x006D,
+		Name:                "TLS_DH_anon_WITH_AES_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00A7,
+		Name:                "TLS_DH_anon_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC046,
+		Name:                "TLS_DH_anon_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC05A,
+		Name:                "TLS_DH_anon_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC047,
+		Name:                "TLS_DH_anon_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC05B,
+		Name:                "TLS_DH_anon_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA": {
+		ID:                  0x0046,
+		Name:                "TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0x00BF,
+		Name:                "TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC084,
+		Name:                "TLS_DH_anon_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA256": {
+		ID:                  0x00C5,
+		Name:                "TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC085,
+		Name:                "TLS_DH_anon_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_DES_CBC_SHA": {
+		ID:                  0x001A,
+		Name:                "TLS_DH_anon_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_RC4_128_MD5": {
+		ID:                  0x0018,
+		Name:                "TLS_DH_anon_WITH_RC4_128_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_DH_anon_WITH_SEED_CBC_SHA": {
+		ID:                  0x009B,
+		Name:                "TLS_DH_anon_WITH_SEED_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SEED CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_ECDSA_WITH_NULL_SHA": {
+		ID:                  0xC006,
+		Name:                "TLS_ECDHE_ECDSA_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_ECDSA_WITH_RC4_128_SHA": {
+		ID:                  0xC007,
+		Name:                "TLS_ECDHE_ECDSA_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_NULL_SHA": {
+		ID:                  0xC039,
+		Name:                "TLS_ECDHE_PSK_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_NULL_SHA256": {
+		ID:                  0xC03A,
+		Name:                "TLS_ECDHE_PSK_WITH_NULL_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_NULL_SHA384": {
+		ID:                  0xC03B,
+		Name:                "TLS_ECDHE_PSK_WITH_NULL_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDHE_PSK_WITH_RC4_128_SHA": {
+		ID:                  0xC033,
+		Name:                "TLS_ECDHE_PSK_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_RSA_WITH_NULL_SHA": {
+		ID:                  0xC010,
+		Name:                "TLS_ECDHE_RSA_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDHE_RSA_WITH_RC4_128_SHA": {
+		ID:                  0xC011,
+		Name:                "TLS_ECDHE_RSA_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC003,
+		Name:                "TLS_ECDH_ECDSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0xC025,
+		Name:                "TLS_ECDH_ECDSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0xC02D,
+		Name:                "TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA384": {
+		ID:                  0xC026,
+		Name:                "TLS_ECDH_ECDSA_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0xC02E,
+		Name:                "TLS_ECDH_ECDSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC04A,
+		Name:                "TLS_ECDH_ECDSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC05E,
+		Name:                "TLS_ECDH_ECDSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC04B,
+		Name:                "TLS_ECDH_ECDSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC05F,
+		Name:                "TLS_ECDH_ECDSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC074,
+		Name:                "TLS_ECDH_ECDSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC088,
+		Name:                "TLS_ECDH_ECDSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC075,
+		Name:                "TLS_ECDH_ECDSA_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC089,
+		Name:                "TLS_ECDH_ECDSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_NULL_SHA": {
+		ID:                  0xC001,
+		Name:                "TLS_ECDH_ECDSA_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_ECDSA_WITH_RC4_128_SHA": {
+		ID:                  0xC002,
+		Name:                "TLS_ECDH_ECDSA_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC00D,
+		Name:                "TLS_ECDH_RSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC00E,
+		Name:                "TLS_ECDH_RSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0xC029,
+		Name:                "TLS_ECDH_RSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0xC031,
+		Name:                "TLS_ECDH_RSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC00F,
+		Name:                "TLS_ECDH_RSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_AES_256_CBC_SHA384": {
+		ID:                  0xC02A,
+		Name:                "TLS_ECDH_RSA_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0xC032,
+		Name:                "TLS_ECDH_RSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC04E,
+		Name:                "TLS_ECDH_RSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC062,
+		Name:                "TLS_ECDH_RSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC04F,
+		Name:                "TLS_ECDH_RSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC063,
+		Name:                "TLS_ECDH_RSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC078,
+		Name:                "TLS_ECDH_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC08C,
+		Name:                "TLS_ECDH_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC079,
+		Name:                "TLS_ECDH_RSA_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC08D,
+		Name:                "TLS_ECDH_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_NULL_SHA": {
+		ID:                  0xC00B,
+		Name:                "TLS_ECDH_RSA_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_RSA_WITH_RC4_128_SHA": {
+		ID:                  0xC00C,
+		Name:                "TLS_ECDH_RSA_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_anon_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0xC017,
+		Name:                "TLS_ECDH_anon_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_anon_WITH_AES_128_CBC_SHA": {
+		ID:                  0xC018,
+		Name:                "TLS_ECDH_anon_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_anon_WITH_AES_256_CBC_SHA": {
+		ID:                  0xC019,
+		Name:                "TLS_ECDH_anon_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_anon_WITH_NULL_SHA": {
+		ID:                  0xC015,
+		Name:                "TLS_ECDH_anon_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_ECDH_anon_WITH_RC4_128_SHA": {
+		ID:                  0xC016,
+		Name:                "TLS_ECDH_anon_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_EXPORT_WITH_DES_CBC_40_MD5": {
+		ID:                  0x0029,
+		Name:                "TLS_KRB5_EXPORT_WITH_DES_CBC_40_MD5",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES CBC 40",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_KRB5_EXPORT_WITH_DES_CBC_40_SHA": {
+		ID:                  0x0026,
+		Name:                "TLS_KRB5_EXPORT_WITH_DES_CBC_40_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES CBC 40",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_EXPORT_WITH_RC2_CBC_40_MD5": {
+		ID:                  0x002A,
+		Name:                "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_MD5",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC2 CBC 40",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_KRB5_EXPORT_WITH_RC2_CBC_40_SHA": {
+		ID:                  0x0027,
+		Name:                "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC2 CBC 40",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_EXPORT_WITH_RC4_40_MD5": {
+		ID:                  0x002B,
+		Name:                "TLS_KRB5_EXPORT_WITH_RC4_40_MD5",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC4 40",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_KRB5_EXPORT_WITH_RC4_40_SHA": {
+		ID:                  0x0028,
+		Name:                "TLS_KRB5_EXPORT_WITH_RC4_40_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC4 40",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_3DES_EDE_CBC_MD5": {
+		ID:                  0x0023,
+		Name:                "TLS_KRB5_WITH_3DES_EDE_CBC_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_DES_CBC_MD5": {
+		ID:                  0x0022,
+		Name:                "TLS_KRB5_WITH_DES_CBC_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_DES_CBC_SHA": {
+		ID:                  0x001E,
+		Name:                "TLS_KRB5_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_IDEA_CBC_MD5": {
+		ID:                  0x0025,
+		Name:                "TLS_KRB5_WITH_IDEA_CBC_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "IDEA CBC",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_IDEA_CBC_SHA": {
+		ID:                  0x0021,
+		Name:                "TLS_KRB5_WITH_IDEA_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "IDEA CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_RC4_128_MD5": {
+		ID:                  0x0024,
+		Name:                "TLS_KRB5_WITH_RC4_128_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+			},
+		},
+	},
+	"TLS_KRB5_WITH_RC4_128_SHA": {
+		ID:                  0x0020,
+		Name:                "TLS_KRB5_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_NULL_WITH_NULL_NULL": {
+		ID:                  0x0000,
+		Name:                "TLS_NULL_WITH_NULL_NULL",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL NULL",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_PSK_DHE_WITH_AES_128_CCM_8": {
+		ID:                  0xC0AA,
+		Name:                "TLS_PSK_DHE_WITH_AES_128_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_DHE_WITH_AES_256_CCM_8": {
+		ID:                  0xC0AB,
+		Name:                "TLS_PSK_DHE_WITH_AES_256_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+	},
+	"TLS_PSK_WITH_NULL_SHA": {
+		ID:                  0x002C,
+		Name:                "TLS_PSK_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_PSK_WITH_NULL_SHA256": {
+		ID:                  0x00B0,
+		Name:                "TLS_PSK_WITH_NULL_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_PSK_WITH_NULL_SHA384": {
+		ID:                  0x00B1,
+		Name:                "TLS_PSK_WITH_NULL_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+		},
+	},
+	"TLS_PSK_WITH_RC4_128_SHA": {
+		ID:                  0x008A,
+		Name:                "TLS_PSK_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_EXPORT_WITH_DES40_CBC_SHA": {
+		ID:                  0x0008,
+		Name:                "TLS_RSA_EXPORT_WITH_DES40_CBC_SHA",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "DES40 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_EXPORT_WITH_RC2_CBC_40_MD5": {
+		ID:                  0x0006,
+		Name:                "TLS_RSA_EXPORT_WITH_RC2_CBC_40_MD5",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC2 CBC 40",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        40,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_RSA_EXPORT_WITH_RC4_40_MD5": {
+		ID:                  0x0003,
+		Name:                "TLS_RSA_EXPORT_WITH_RC4_40_MD5",
+		ProtocolVersion:     "TLS EXPORT",
+		EncryptionAlgorithm: "RC4 40",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x0093,
+		Name:                "TLS_RSA_PSK_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_AES_128_CBC_SHA": {
+		ID:                  0x0094,
+		Name:                "TLS_RSA_PSK_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x00B6,
+		Name:                "TLS_RSA_PSK_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x00AC,
+		Name:                "TLS_RSA_PSK_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0095,
+		Name:                "TLS_RSA_PSK_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_AES_256_CBC_SHA384": {
+		ID:                  0x00B7,
+		Name:                "TLS_RSA_PSK_WITH_AES_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x00AD,
+		Name:                "TLS_RSA_PSK_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC068,
+		Name:                "TLS_RSA_PSK_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC06E,
+		Name:                "TLS_RSA_PSK_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC069,
+		Name:                "TLS_RSA_PSK_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC06F,
+		Name:                "TLS_RSA_PSK_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0xC098,
+		Name:                "TLS_RSA_PSK_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC092,
+		Name:                "TLS_RSA_PSK_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_CAMELLIA_256_CBC_SHA384": {
+		ID:                  0xC099,
+		Name:                "TLS_RSA_PSK_WITH_CAMELLIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC093,
+		Name:                "TLS_RSA_PSK_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_CHACHA20_POLY1305_SHA256": {
+		ID:                  0xCCAE,
+		Name:                "TLS_RSA_PSK_WITH_CHACHA20_POLY1305_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CHACHA20 POLY1305",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_NULL_SHA": {
+		ID:                  0x002E,
+		Name:                "TLS_RSA_PSK_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_NULL_SHA256": {
+		ID:                  0x00B8,
+		Name:                "TLS_RSA_PSK_WITH_NULL_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_NULL_SHA384": {
+		ID:                  0x00B9,
+		Name:                "TLS_RSA_PSK_WITH_NULL_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since: time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+			},
+		},
+	},
+	"TLS_RSA_PSK_WITH_RC4_128_SHA": {
+		ID:                  0x0092,
+		Name:                "TLS_RSA_PSK_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_3DES_EDE_CBC_SHA": {
+		ID:                  0x000A,
+		Name:                "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "3DES EDE CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        112,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+				"Chrome 93 removes 3DES cipher suites",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_128_CBC_SHA": {
+		ID:                  0x002F,
+		Name:                "TLS_RSA_WITH_AES_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_128_CBC_SHA256": {
+		ID:                  0x003C,
+		Name:                "TLS_RSA_WITH_AES_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_128_CCM": {
+		ID:                  0xC09C,
+		Name:                "TLS_RSA_WITH_AES_128_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_128_CCM_8": {
+		ID:                  0xC0A0,
+		Name:                "TLS_RSA_WITH_AES_128_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_128_GCM_SHA256": {
+		ID:                  0x009C,
+		Name:                "TLS_RSA_WITH_AES_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_256_CBC_SHA": {
+		ID:                  0x0035,
+		Name:                "TLS_RSA_WITH_AES_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_256_CBC_SHA256": {
+		ID:                  0x003D,
+		Name:                "TLS_RSA_WITH_AES_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_256_CCM": {
+		ID:                  0xC09D,
+		Name:                "TLS_RSA_WITH_AES_256_CCM",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_256_CCM_8": {
+		ID:                  0xC0A1,
+		Name:                "TLS_RSA_WITH_AES_256_CCM_8",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 CCM 8",
+		HashAlgorithm:       "",
+		Classification:      Insecure,
+		SecurityBits:        64,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_AES_256_GCM_SHA384": {
+		ID:                  0x009D,
+		Name:                "TLS_RSA_WITH_AES_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "AES 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_ARIA_128_CBC_SHA256": {
+		ID:                  0xC03C,
+		Name:                "TLS_RSA_WITH_ARIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_ARIA_128_GCM_SHA256": {
+		ID:                  0xC050,
+		Name:                "TLS_RSA_WITH_ARIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_ARIA_256_CBC_SHA384": {
+		ID:                  0xC03D,
+		Name:                "TLS_RSA_WITH_ARIA_256_CBC_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 CBC",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_ARIA_256_GCM_SHA384": {
+		ID:                  0xC051,
+		Name:                "TLS_RSA_WITH_ARIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "ARIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_CAMELLIA_128_CBC_SHA": {
+		ID:                  0x0041,
+		Name:                "TLS_RSA_WITH_CAMELLIA_128_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_CAMELLIA_128_CBC_SHA256": {
+		ID:                  0x00BA,
+		Name:                "TLS_RSA_WITH_CAMELLIA_128_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_CAMELLIA_128_GCM_SHA256": {
+		ID:                  0xC07A,
+		Name:                "TLS_RSA_WITH_CAMELLIA_128_GCM_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 128 GCM",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_CAMELLIA_256_CBC_SHA": {
+		ID:                  0x0084,
+		Name:                "TLS_RSA_WITH_CAMELLIA_256_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_CAMELLIA_256_CBC_SHA256": {
+		ID:                  0x00C0,
+		Name:                "TLS_RSA_WITH_CAMELLIA_256_CBC_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 CBC",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_CAMELLIA_256_GCM_SHA384": {
+		ID:                  0xC07B,
+		Name:                "TLS_RSA_WITH_CAMELLIA_256_GCM_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "CAMELLIA 256 GCM",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        256,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_DES_CBC_SHA": {
+		ID:                  0x0009,
+		Name:                "TLS_RSA_WITH_DES_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "DES CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        56,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_IDEA_CBC_SHA": {
+		ID:                  0x0007,
+		Name:                "TLS_RSA_WITH_IDEA_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "IDEA CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2009, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_NULL_MD5": {
+		ID:                  0x0001,
+		Name:                "TLS_RSA_WITH_NULL_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_NULL_SHA": {
+		ID:                  0x0002,
+		Name:                "TLS_RSA_WITH_NULL_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_NULL_SHA256": {
+		ID:                  0x003B,
+		Name:                "TLS_RSA_WITH_NULL_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "NULL",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_RC4_128_MD5": {
+		ID:                  0x0004,
+		Name:                "TLS_RSA_WITH_RC4_128_MD5",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "MD5",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+		},
+	},
+	"TLS_RSA_WITH_RC4_128_SHA": {
+		ID:                  0x0005,
+		Name:                "TLS_RSA_WITH_RC4_128_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "RC4 128",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2015, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2016, time.January, 20, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 7465: RC4 cipher suites are prohibited",
+				"Chrome 48 removes RC4 cipher suites",
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_RSA_WITH_SEED_CBC_SHA": {
+		ID:                  0x0096,
+		Name:                "TLS_RSA_WITH_SEED_CBC_SHA",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SEED CBC",
+		HashAlgorithm:       "SHA",
+		Classification:      Insecure,
+		SecurityBits:        128,
+		TLSVersions:         []string{"TLS1.0", "TLS1.1", "TLS1.2", "TLS1.3"},
+		Deprecation: &Deprecation{
+			Since:   time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC),
+			Removal: time.Date(2024, time.February, 6, 0, 0, 0, 0, time.UTC),
+			Sources: []string{
+				"RFC 9325: static RSA key exchange should not be negotiated",
+				"Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+				"NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	},
+	"TLS_SHA256_SHA256": {
+		ID:                  0xC0B4,
+		Name:                "TLS_SHA256_SHA256",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SHA256",
+		HashAlgorithm:       "SHA256",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
+	"TLS_SHA384_SHA384": {
+		ID:                  0xC0B5,
+		Name:                "TLS_SHA384_SHA384",
+		ProtocolVersion:     "TLS",
+		EncryptionAlgorithm: "SHA384",
+		HashAlgorithm:       "SHA384",
+		Classification:      Insecure,
+		SecurityBits:        0,
+		TLSVersions:         []string{"TLS1.2", "TLS1.3"},
+	},
 }
@@ -1,16 +1,36 @@
-//go:generate go run cmd/generator/main.go
+//go:generate go run ./cmd/generate
 package ciphersuites
 
 // CipherSuite represents the security attributes associated to a cipher suite.
 type CipherSuite struct {
+	// ID is the identifier assigned by IANA, such as 0x1301, as found in
+	// [tls.ConnectionState.CipherSuite]. A [Registry] looks cipher suites up
+	// by it unless it is zero.
+	ID uint16
+
+	// Name is the IANA name of the cipher suite, such as
+	// TLS_AES_128_GCM_SHA256.
+	Name string
+
 	ProtocolVersion     string
 	EncryptionAlgorithm string
 	HashAlgorithm       string
 	Classification      Classification
 
+	// SecurityBits is the effective security strength of the cipher suite in
+	// bits: the strength of its cipher, bounded by the length of its
+	// authentication tag and, where it is known from the name alone, by its
+	// key exchange. Export cipher suites provide at most 40 bits, 3DES 112
+	// bits and CCM_8 64 bits. Cipher suites without encryption, with RC4 or
+	// with anonymous key exchange provide none.
+	SecurityBits int
+
 	// Supported versions of the TLS protocol that can negotiate this cipher
 	// suite.
 	TLSVersions []string
+
+	// Deprecation is nil if the cipher suite has not been deprecated.
+	Deprecation *Deprecation
 }
 
 // IsRecommended returns true if the cipher suite is secure and recommended for
@@ -36,21 +56,44 @@ func (a CipherSuite) IsInsecure() bool {
 
 // GetCipherSuite retrieves the [CipherSuite] by its name.
 func GetCipherSuite(cipherSuite string) (CipherSuite, bool) {
-	if cs, ok := RecommendedCipherSuites[cipherSuite]; ok {
-		return cs, true
-	}
+	return defaultRegistry.GetCipherSuite(cipherSuite)
+}
+
+// GetCipherSuiteByID retrieves the [CipherSuite] by its IANA assigned
+// identifier, such as the value found in [tls.ConnectionState.CipherSuite].
+// Every cipher suite in the registry can be found, not only those implemented
+// by the crypto/tls package.
+func GetCipherSuiteByID(id uint16) (CipherSuite, bool) {
+	return defaultRegistry.GetCipherSuiteByID(id)
+}
+
+// CipherSuiteName returns the IANA name of the cipher suite with the given
+// identifier. Unlike [tls.CipherSuiteName] it knows every cipher suite in the
+// registry, not only those implemented by crypto/tls.
+func CipherSuiteName(id uint16) (string, bool) {
+	return defaultRegistry.CipherSuiteName(id)
+}
 
-	if cs, ok := SecureCipherSuites[cipherSuite]; ok {
-		return cs, true
-	}
+// CipherSuiteID returns the IANA assigned identifier of the named cipher
+// suite.
+func CipherSuiteID(name string) (uint16, bool) {
+	return defaultRegistry.CipherSuiteID(name)
+}
 
-	if cs, ok := WeakCipherSuites[cipherSuite]; ok {
-		return cs, true
-	}
+// CipherSuites returns every known cipher suite, ordered by name.
+func CipherSuites() []CipherSuite {
+	return defaultRegistry.CipherSuites()
+}
 
-	if cs, ok := InsecureCipherSuites[cipherSuite]; ok {
-		return cs, true
-	}
+// CipherSuitesClassified returns the known cipher suites with the given
+// classification, ordered by name.
+func CipherSuitesClassified(c Classification) []CipherSuite {
+	return defaultRegistry.CipherSuitesClassified(c)
+}
 
-	return CipherSuite{}, false
+// SuitesWithStrengthAtLeast returns the known cipher suites providing at
+// least the given number of bits of security, as reported by
+// [CipherSuite.SecurityBits], ordered by name.
+func SuitesWithStrengthAtLeast(bits int) []CipherSuite {
+	return defaultRegistry.SuitesWithStrengthAtLeast(bits)
 }
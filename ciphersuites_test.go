@@ -1,6 +1,7 @@
 package ciphersuites_test
 
 import (
+	"crypto/tls"
 	"testing"
 
 	"github.com/tomasbasham/ciphersuites"
@@ -16,6 +17,7 @@ func TestGetCipherSuite(t *testing.T) {
 		"returns recommended": {
 			cipherSuite: "TLS_AES_128_CCM_SHA256",
 			want: ciphersuites.CipherSuite{
+				Name:                "TLS_AES_128_CCM_SHA256",
 				ProtocolVersion:     "TLS",
 				EncryptionAlgorithm: "AES 128 CCM",
 				HashAlgorithm:       "SHA256",
@@ -26,6 +28,7 @@ func TestGetCipherSuite(t *testing.T) {
 		"returns secure": {
 			cipherSuite: "TLS_AES_128_CCM_8_SHA256",
 			want: ciphersuites.CipherSuite{
+				Name:                "TLS_AES_128_CCM_8_SHA256",
 				ProtocolVersion:     "TLS",
 				EncryptionAlgorithm: "AES 128 CCM 8",
 				HashAlgorithm:       "SHA256",
@@ -36,6 +39,7 @@ func TestGetCipherSuite(t *testing.T) {
 		"returns weak": {
 			cipherSuite: "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA",
 			want: ciphersuites.CipherSuite{
+				Name:                "TLS_DH_RSA_WITH_CAMELLIA_256_CBC_SHA",
 				ProtocolVersion:     "TLS",
 				EncryptionAlgorithm: "CAMELLIA 256 CBC",
 				HashAlgorithm:       "SHA",
@@ -46,6 +50,7 @@ func TestGetCipherSuite(t *testing.T) {
 		"returns insecure": {
 			cipherSuite: "TLS_DH_anon_WITH_RC4_128_MD5",
 			want: ciphersuites.CipherSuite{
+				Name:                "TLS_DH_anon_WITH_RC4_128_MD5",
 				ProtocolVersion:     "TLS",
 				EncryptionAlgorithm: "RC4 128",
 				HashAlgorithm:       "MD5",
@@ -80,6 +85,77 @@ func TestGetCipherSuite(t *testing.T) {
 	}
 }
 
+func TestGetCipherSuiteByID(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		id    uint16
+		want  ciphersuites.Classification
+		found bool
+	}{
+		"returns recommended": {
+			id:    tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			want:  ciphersuites.Recommended,
+			found: true,
+		},
+		"returns weak": {
+			id:    tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+			want:  ciphersuites.Weak,
+			found: true,
+		},
+		"returns insecure": {
+			id:    tls.TLS_RSA_WITH_RC4_128_SHA,
+			want:  ciphersuites.Insecure,
+			found: true,
+		},
+		"returns cipher suite unknown to crypto/tls and OpenSSL": {
+			id:    0x0016,
+			want:  ciphersuites.Insecure,
+			found: true,
+		},
+		"returns cipher suite with zero identifier": {
+			id:    0x0000,
+			want:  ciphersuites.Insecure,
+			found: true,
+		},
+		"returns unknown": {
+			id:    0xFFFF,
+			want:  ciphersuites.Unknown,
+			found: false,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ciphersuites.GetCipherSuiteByID(tt.id)
+			if ok != tt.found {
+				t.Fatalf("mismatch:\n  got:  %t\n  want: %t", ok, tt.found)
+			}
+			if got.Classification != tt.want {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", got.Classification, tt.want)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteIDs(t *testing.T) {
+	t.Parallel()
+
+	for _, cs := range ciphersuites.CipherSuites() {
+		if _, ok := ciphersuites.CipherSuiteID(cs.Name); !ok {
+			t.Errorf("mismatch:\n  got:  %s has no identifier of its own\n  want: distinct identifiers", cs.Name)
+		}
+	}
+
+	for _, want := range tls.CipherSuites() {
+		if id, _ := ciphersuites.CipherSuiteID(want.Name); id != want.ID {
+			t.Errorf("mismatch:\n  got:  %s is %#04x\n  want: %#04x", want.Name, id, want.ID)
+		}
+	}
+}
+
 func TestIsRecommended(t *testing.T) {
 	t.Parallel()
 
@@ -205,7 +281,8 @@ func TestIsInsecure(t *testing.T) {
 }
 
 func cipherSuiteEqual(a, b ciphersuites.CipherSuite) bool {
-	return a.ProtocolVersion == b.ProtocolVersion &&
+	return a.Name == b.Name &&
+		a.ProtocolVersion == b.ProtocolVersion &&
 		a.EncryptionAlgorithm == b.EncryptionAlgorithm &&
 		a.HashAlgorithm == b.HashAlgorithm &&
 		a.Classification == b.Classification
@@ -1,5 +1,10 @@
 package ciphersuites
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Classification specifies the security class a cipher suite falls under.
 type Classification byte
 
@@ -33,25 +38,43 @@ func (c Classification) String() string {
 	}
 }
 
-// GetClassification returns the security classification of a given cipher
-// suite. If the cipher suite cannot be found then its classification is
-// unknown.
-func GetClassification(cipherSuite string) Classification {
-	if cs, ok := RecommendedCipherSuites[cipherSuite]; ok {
-		return cs.Classification
-	}
-
-	if cs, ok := SecureCipherSuites[cipherSuite]; ok {
-		return cs.Classification
+// ParseClassification returns the classification named s, ignoring case. It
+// is the inverse of [Classification.String].
+func ParseClassification(s string) (Classification, error) {
+	for _, c := range []Classification{Unknown, Recommended, Secure, Weak, Insecure} {
+		if strings.EqualFold(c.String(), s) {
+			return c, nil
+		}
 	}
+	return Unknown, fmt.Errorf("unknown classification %q", s)
+}
 
-	if cs, ok := WeakCipherSuites[cipherSuite]; ok {
-		return cs.Classification
-	}
+// AtLeast reports whether the classification is as strong as, or stronger
+// than, min. An unknown classification is weaker than every other
+// classification, so it only satisfies a minimum of [Unknown].
+func (c Classification) AtLeast(min Classification) bool {
+	return c.rank() >= min.rank()
+}
 
-	if cs, ok := InsecureCipherSuites[cipherSuite]; ok {
-		return cs.Classification
+// rank orders classifications from weakest to strongest.
+func (c Classification) rank() int {
+	switch c {
+	case Recommended:
+		return 4
+	case Secure:
+		return 3
+	case Weak:
+		return 2
+	case Insecure:
+		return 1
+	default:
+		return 0
 	}
+}
 
-	return Unknown
+// GetClassification returns the security classification of a given cipher
+// suite. If the cipher suite cannot be found then its classification is
+// unknown.
+func GetClassification(cipherSuite string) Classification {
+	return defaultRegistry.GetClassification(cipherSuite)
 }
@@ -47,6 +47,94 @@ func TestClassification(t *testing.T) {
 	}
 }
 
+func TestClassificationAtLeast(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		classification ciphersuites.Classification
+		min            ciphersuites.Classification
+		want           bool
+	}{
+		"returns true for equal": {
+			classification: ciphersuites.Secure,
+			min:            ciphersuites.Secure,
+			want:           true,
+		},
+		"returns true for stronger": {
+			classification: ciphersuites.Recommended,
+			min:            ciphersuites.Weak,
+			want:           true,
+		},
+		"returns false for weaker": {
+			classification: ciphersuites.Insecure,
+			min:            ciphersuites.Weak,
+			want:           false,
+		},
+		"returns false for unknown": {
+			classification: ciphersuites.Unknown,
+			min:            ciphersuites.Insecure,
+			want:           false,
+		},
+		"returns true for unknown minimum": {
+			classification: ciphersuites.Unknown,
+			min:            ciphersuites.Unknown,
+			want:           true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.classification.AtLeast(tt.min)
+			if got != tt.want {
+				t.Errorf("mismatch:\n  got:  %t\n  want: %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseClassification(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		input   string
+		want    ciphersuites.Classification
+		wantErr bool
+	}{
+		"parses lower case": {
+			input: "secure",
+			want:  ciphersuites.Secure,
+		},
+		"parses mixed case": {
+			input: "Recommended",
+			want:  ciphersuites.Recommended,
+		},
+		"parses unknown": {
+			input: "unknown",
+			want:  ciphersuites.Unknown,
+		},
+		"rejects unrecognised": {
+			input:   "excellent",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ciphersuites.ParseClassification(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetClassification(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// certificateReport is the output of the cert command.
+type certificateReport struct {
+	Certificate          string             `json:"certificate"`
+	KeyType              string             `json:"key_type"`
+	Chain                []chainCertificate `json:"chain"`
+	ChainClassification  string             `json:"chain_classification"`
+	Reasons              []string           `json:"reasons"`
+	Usable               []changedCipher    `json:"usable"`
+	Unusable             []unusableCipher   `json:"unusable"`
+	NoCertificate        []changedCipher    `json:"no_certificate"`
+	RecommendedRemaining bool               `json:"recommended_remaining"`
+}
+
+// chainCertificate describes the key and signature of a certificate in the
+// chain.
+type chainCertificate struct {
+	Subject                 string `json:"subject"`
+	PublicKey               string `json:"public_key"`
+	KeyClassification       string `json:"key_classification"`
+	Signature               string `json:"signature"`
+	SignatureClassification string `json:"signature_classification"`
+}
+
+type unusableCipher struct {
+	changedCipher
+	Reason string `json:"reason"`
+}
+
+func runCert(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("cert", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites cert [flags] <certificate.pem> <suites>")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "The first certificate in the PEM file is checked, so a chain may be given")
+		fmt.Fprintln(flags.Output(), "with the leaf certificate first. The list holds IANA names, identifiers or")
+		fmt.Fprintln(flags.Output(), "OpenSSL names separated by colons, commas or whitespace. Prefix it with @ to")
+		fmt.Fprintln(flags.Output(), "read it from a file.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return errors.New("expected a certificate file and a cipher suite list")
+	}
+
+	certs, err := ciphersuites.LoadCertificateFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	suites, err := readCipherList(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	leaf := certs[0]
+	c := ciphersuites.CheckCertificate(leaf, suites)
+	chain := ciphersuites.ClassifyChain(certs)
+	summary := certificateReport{
+		Certificate:          leaf.Subject.String(),
+		KeyType:              c.KeyType.String(),
+		ChainClassification:  chain.Classification.String(),
+		Reasons:              chain.Reasons,
+		Usable:               changedCiphers(c.Usable),
+		Unusable:             []unusableCipher{},
+		NoCertificate:        changedCiphers(c.NoCertificate),
+		RecommendedRemaining: c.RecommendedRemaining(),
+	}
+	for _, a := range chain.Certificates {
+		summary.Chain = append(summary.Chain, chainCertificate{
+			Subject:                 a.Certificate.Subject.String(),
+			PublicKey:               a.Certificate.PublicKeyAlgorithm.String(),
+			KeyClassification:       a.PublicKey.Classification.String(),
+			Signature:               a.Certificate.SignatureAlgorithm.String(),
+			SignatureClassification: a.Signature.Classification.String(),
+		})
+	}
+	for _, u := range c.Unusable {
+		summary.Unusable = append(summary.Unusable, unusableCipher{
+			changedCipher: changedCipher{
+				CipherSuite:    u.CipherSuite.Name,
+				Classification: u.CipherSuite.Classification.String(),
+			},
+			Reason: u.Reason,
+		})
+	}
+
+	switch *format {
+	case "table":
+		return writeCertificateTable(stdout, summary)
+	case "json":
+		return writeJSON(stdout, summary)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func writeCertificateTable(w io.Writer, summary certificateReport) error {
+	fmt.Fprintf(w, "Certificate: %s\n", orDash(summary.Certificate))
+	fmt.Fprintf(w, "Key type: %s\n", summary.KeyType)
+	fmt.Fprintf(w, "Chain: %s\n\n", summary.ChainClassification)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SUBJECT\tPUBLIC KEY\tCLASSIFICATION\tSIGNATURE\tCLASSIFICATION")
+	for _, c := range summary.Chain {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", orDash(c.Subject), c.PublicKey, c.KeyClassification, c.Signature, c.SignatureClassification)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+	for _, reason := range summary.Reasons {
+		fmt.Fprintf(w, "  %s\n", reason)
+	}
+	fmt.Fprintln(w)
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tCIPHER SUITE\tCLASSIFICATION\tREASON")
+	for _, c := range summary.Usable {
+		fmt.Fprintf(tw, "usable\t%s\t%s\t-\n", c.CipherSuite, c.Classification)
+	}
+	for _, c := range summary.NoCertificate {
+		fmt.Fprintf(tw, "no certificate\t%s\t%s\t-\n", c.CipherSuite, c.Classification)
+	}
+	for _, c := range summary.Unusable {
+		fmt.Fprintf(tw, "unusable\t%s\t%s\t%s\n", c.CipherSuite, c.Classification, c.Reason)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	remaining := "no"
+	if summary.RecommendedRemaining {
+		remaining = "yes"
+	}
+	fmt.Fprintf(w, "\nRecommended cipher suite remaining: %s\n", remaining)
+	return nil
+}
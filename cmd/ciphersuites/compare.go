@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// comparisonReport is the output of the compare command.
+type comparisonReport struct {
+	Added              []changedCipher `json:"added"`
+	Removed            []changedCipher `json:"removed"`
+	WeakestBefore      string          `json:"weakest_before"`
+	WeakestAfter       string          `json:"weakest_after"`
+	Weakest            string          `json:"weakest"`
+	UnclassifiedBefore []string        `json:"unclassified_before"`
+	UnclassifiedAfter  []string        `json:"unclassified_after"`
+	VersionsGained     []string        `json:"versions_gained"`
+	VersionsLost       []string        `json:"versions_lost"`
+}
+
+type changedCipher struct {
+	CipherSuite    string `json:"cipher_suite"`
+	Classification string `json:"classification"`
+}
+
+func runCompare(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("compare", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites compare [flags] <before> <after>")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "Each list holds IANA names, identifiers or OpenSSL names separated by")
+		fmt.Fprintln(flags.Output(), "colons, commas or whitespace. Prefix a list with @ to read it from a file.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return errors.New("expected exactly two cipher suite lists")
+	}
+
+	before, err := readCipherList(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	after, err := readCipherList(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	diff := ciphersuites.DiffConfigurations(before, after)
+	summary := comparisonReport{
+		Added:              changedCiphers(diff.Added),
+		Removed:            changedCiphers(diff.Removed),
+		WeakestBefore:      weakestName(diff.WeakestBefore),
+		WeakestAfter:       weakestName(diff.WeakestAfter),
+		Weakest:            string(diff.Weakest),
+		UnclassifiedBefore: append([]string{}, diff.UnclassifiedBefore...),
+		UnclassifiedAfter:  append([]string{}, diff.UnclassifiedAfter...),
+		VersionsGained:     append([]string{}, diff.VersionsGained...),
+		VersionsLost:       append([]string{}, diff.VersionsLost...),
+	}
+
+	switch *format {
+	case "table":
+		return writeComparisonTable(stdout, summary)
+	case "json":
+		return writeJSON(stdout, summary)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+// readCipherList parses a cipher suite list given on the command line, or
+// read from a file if it starts with @.
+func readCipherList(arg string) ([]string, error) {
+	if strings.HasPrefix(arg, "@") {
+		b, err := os.ReadFile(arg[1:])
+		if err != nil {
+			return nil, err
+		}
+		arg = string(b)
+	}
+	return ciphersuites.ParseCipherList(arg)
+}
+
+// weakestName names the weakest classification of a configuration, which is
+// "none" if no classified cipher suite is enabled.
+func weakestName(c ciphersuites.Classification) string {
+	if c == ciphersuites.Unknown {
+		return "none"
+	}
+	return c.String()
+}
+
+func changedCiphers(suites []ciphersuites.CipherSuite) []changedCipher {
+	changed := []changedCipher{}
+	for _, cs := range suites {
+		changed = append(changed, changedCipher{
+			CipherSuite:    cs.Name,
+			Classification: cs.Classification.String(),
+		})
+	}
+	return changed
+}
+
+func writeComparisonTable(w io.Writer, summary comparisonReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHANGE\tCIPHER SUITE\tCLASSIFICATION")
+	for _, c := range summary.Added {
+		fmt.Fprintf(tw, "+\t%s\t%s\n", c.CipherSuite, c.Classification)
+	}
+	for _, c := range summary.Removed {
+		fmt.Fprintf(tw, "-\t%s\t%s\n", c.CipherSuite, c.Classification)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nWeakest cipher suite: %s -> %s (%s)\n", summary.WeakestBefore, summary.WeakestAfter, summary.Weakest)
+	if len(summary.UnclassifiedBefore) > 0 || len(summary.UnclassifiedAfter) > 0 {
+		fmt.Fprintf(w, "Unclassified cipher suites: %s -> %s\n", orDash(strings.Join(summary.UnclassifiedBefore, ", ")), orDash(strings.Join(summary.UnclassifiedAfter, ", ")))
+	}
+	fmt.Fprintf(w, "Versions gained: %s\n", orDash(strings.Join(summary.VersionsGained, ", ")))
+	fmt.Fprintf(w, "Versions lost: %s\n", orDash(strings.Join(summary.VersionsLost, ", ")))
+	return nil
+}
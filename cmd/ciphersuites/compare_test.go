@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		"reports tls 1.3 lost": {
+			args: []string{"TLS_AES_128_GCM_SHA256:ECDHE-RSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256"},
+			want: `CHANGE  CIPHER SUITE            CLASSIFICATION
+-       TLS_AES_128_GCM_SHA256  recommended
+
+Weakest cipher suite: recommended -> recommended (unchanged)
+Versions gained: -
+Versions lost: TLS1.3
+`,
+		},
+		"removing every cipher suite improves": {
+			args: []string{"AES128-SHA", ""},
+			want: `CHANGE  CIPHER SUITE                  CLASSIFICATION
+-       TLS_RSA_WITH_AES_128_CBC_SHA  insecure
+
+Weakest cipher suite: insecure -> none (improved)
+Versions gained: -
+Versions lost: TLS1.0, TLS1.1, TLS1.2
+`,
+		},
+		"adding a weak cipher suite regresses": {
+			args: []string{"-format", "json", "ECDHE-RSA-AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-SHA"},
+			want: `{
+  "added": [
+    {
+      "cipher_suite": "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+      "classification": "weak"
+    }
+  ],
+  "removed": [],
+  "weakest_before": "recommended",
+  "weakest_after": "weak",
+  "weakest": "regressed",
+  "unclassified_before": [],
+  "unclassified_after": [],
+  "versions_gained": [
+    "TLS1.0",
+    "TLS1.1"
+  ],
+  "versions_lost": []
+}
+`,
+		},
+		"requires two lists": {
+			args:    []string{"AES128-SHA"},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+			err := runCompare(tt.args, &stdout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if got := stdout.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("mismatch:\n  got:\n%s\n  want:\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// explanation is the output of the explain command.
+type explanation struct {
+	suiteInfo
+	Summary string   `json:"summary"`
+	Notes   []string `json:"notes"`
+}
+
+// summaries describe what each classification means.
+var summaries = map[ciphersuites.Classification]string{
+	ciphersuites.Recommended: "secure and recommended for current use",
+	ciphersuites.Secure:      "secure, but not the preferred choice",
+	ciphersuites.Weak:        "has known weaknesses and should be avoided",
+	ciphersuites.Insecure:    "cryptographically broken and must not be used",
+}
+
+func runExplain(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("explain", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites explain [flags] <name|id|openssl-name>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("expected exactly one cipher suite")
+	}
+
+	cs, err := resolve(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	e := explain(cs)
+
+	switch *format {
+	case "table":
+		return writeExplanation(stdout, e)
+	case "json":
+		return writeJSON(stdout, e)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func explain(cs ciphersuites.CipherSuite) explanation {
+	e := explanation{
+		suiteInfo: newSuiteInfo(cs),
+		Summary:   summaries[cs.Classification],
+		Notes:     []string{},
+	}
+
+	switch e.KeyExchange {
+	case "any":
+		e.Notes = append(e.Notes, "The key exchange is negotiated separately, as in every TLS 1.3 cipher suite.")
+	case "DHE", "ECDHE":
+		e.Notes = append(e.Notes, "Ephemeral Diffie-Hellman key exchange provides forward secrecy.")
+	default:
+		e.Notes = append(e.Notes, fmt.Sprintf("%s key exchange does not provide forward secrecy.", e.KeyExchange))
+	}
+	if e.Authentication == "anon" {
+		e.Notes = append(e.Notes, "The server is not authenticated, so connections can be intercepted.")
+	}
+
+	encryption := strings.ToUpper(cs.EncryptionAlgorithm)
+	switch {
+	case encryption == "NULL" || encryption == "":
+		e.Notes = append(e.Notes, "Traffic is not encrypted.")
+	case strings.Contains(encryption, "GCM") || strings.Contains(encryption, "CCM") || strings.Contains(encryption, "POLY1305"):
+		e.Notes = append(e.Notes, "Authenticated encryption (AEAD) protects confidentiality and integrity together.")
+	}
+	if cs.HashAlgorithm == "MD5" {
+		e.Notes = append(e.Notes, "MD5 is broken and should not be relied upon for integrity.")
+	}
+
+	for _, attack := range cs.Attacks() {
+		note := fmt.Sprintf("Vulnerable to %s", attack.Name)
+		if len(attack.CVEs) > 0 {
+			note += fmt.Sprintf(" (%s)", strings.Join(attack.CVEs, ", "))
+		}
+		e.Notes = append(e.Notes, fmt.Sprintf("%s: %s", note, attack.Description))
+	}
+
+	if d := cs.Deprecation; d != nil {
+		note := fmt.Sprintf("Deprecated since %s", e.Deprecated)
+		if e.Removed != "" {
+			note += fmt.Sprintf(" and removed from major implementations from %s", e.Removed)
+		}
+		e.Notes = append(e.Notes, fmt.Sprintf("%s (%s).", note, strings.Join(d.Sources, "; ")))
+	}
+	return e
+}
+
+func writeExplanation(w io.Writer, e explanation) error {
+	fmt.Fprintf(w, "%s is %s: %s.\n\n", e.Name, e.Classification, orDash(e.Summary))
+	if err := writeLookupTable(w, []suiteInfo{e.suiteInfo}); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	for _, note := range e.Notes {
+		fmt.Fprintf(w, "  - %s\n", note)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func runList(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	minName := flags.String("min", "unknown", "Minimum classification: recommended, secure, weak or insecure")
+	version := flags.String("version", "", "Only list cipher suites usable with a protocol version, such as tls1.2")
+	bits := flags.Int("bits", 0, "Minimum effective security strength in bits, such as 128")
+	kex := flags.String("kex", "", "Only list cipher suites using a key exchange, such as ecdhe")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites list [flags]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return errors.New("unexpected arguments")
+	}
+
+	minimum, err := ciphersuites.ParseClassification(*minName)
+	if err != nil {
+		return err
+	}
+
+	infos := []suiteInfo{}
+	for _, cs := range ciphersuites.CipherSuites() {
+		if !cs.Classification.AtLeast(minimum) {
+			continue
+		}
+		if cs.SecurityBits < *bits {
+			continue
+		}
+		if *version != "" && !supportsVersion(cs, *version) {
+			continue
+		}
+		info := newSuiteInfo(cs)
+		if *kex != "" && !strings.EqualFold(info.KeyExchange, *kex) {
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	switch *format {
+	case "table":
+		return writeListTable(stdout, infos)
+	case "json":
+		return writeJSON(stdout, infos)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+// supportsVersion reports whether cs can be negotiated with version, which is
+// matched against [ciphersuites.CipherSuite.NegotiableVersions] ignoring case
+// and an optional "v", so that tls1.2, TLSv1.2 and TLS1.2 are equivalent.
+func supportsVersion(cs ciphersuites.CipherSuite, version string) bool {
+	want := normaliseVersion(version)
+	for _, v := range cs.NegotiableVersions() {
+		if normaliseVersion(v) == want {
+			return true
+		}
+	}
+	return false
+}
+
+func normaliseVersion(v string) string {
+	return strings.Replace(strings.ToLower(v), "v", "", 1)
+}
+
+func writeListTable(w io.Writer, infos []suiteInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CIPHER SUITE\tID\tOPENSSL\tCLASSIFICATION\tBITS\tVERSIONS")
+	for _, info := range infos {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			info.Name,
+			orDash(info.ID),
+			orDash(info.OpenSSL),
+			info.Classification,
+			info.SecurityBits,
+			strings.Join(info.TLSVersions, ", "),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n%d cipher suites\n", len(infos))
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		"lists tls 1.3 cipher suites only at tls 1.3": {
+			args: []string{"-min", "recommended", "-version", "TLSv1.3"},
+			want: `CIPHER SUITE                  ID      OPENSSL                       CLASSIFICATION  BITS  VERSIONS
+TLS_AES_128_CCM_SHA256        0x1304  TLS_AES_128_CCM_SHA256        recommended     128   TLS1.3
+TLS_AES_128_GCM_SHA256        0x1301  TLS_AES_128_GCM_SHA256        recommended     128   TLS1.3
+TLS_AES_256_GCM_SHA384        0x1302  TLS_AES_256_GCM_SHA384        recommended     256   TLS1.3
+TLS_CHACHA20_POLY1305_SHA256  0x1303  TLS_CHACHA20_POLY1305_SHA256  recommended     256   TLS1.3
+
+4 cipher suites
+`,
+		},
+		"filters by key exchange and strength": {
+			args: []string{"-min", "recommended", "-kex", "ecdhe", "-bits", "256", "-version", "tls1.2"},
+			want: `CIPHER SUITE                                   ID      OPENSSL                        CLASSIFICATION  BITS  VERSIONS
+TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384        0xC02C  ECDHE-ECDSA-AES256-GCM-SHA384  recommended     256   TLS1.2
+TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256  0xCCA9  ECDHE-ECDSA-CHACHA20-POLY1305  recommended     256   TLS1.2
+TLS_ECDHE_PSK_WITH_AES_256_GCM_SHA384          0xD002  -                              recommended     256   TLS1.2
+TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256    0xCCAC  ECDHE-PSK-CHACHA20-POLY1305    recommended     256   TLS1.2
+TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384          0xC030  ECDHE-RSA-AES256-GCM-SHA384    recommended     256   TLS1.2
+TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256    0xCCA8  ECDHE-RSA-CHACHA20-POLY1305    recommended     256   TLS1.2
+
+6 cipher suites
+`,
+		},
+		"writes json": {
+			args: []string{"-min", "recommended", "-version", "tls1.3", "-bits", "256", "-format", "json"},
+			want: `[
+  {
+    "name": "TLS_AES_256_GCM_SHA384",
+    "id": "0x1302",
+    "openssl": "TLS_AES_256_GCM_SHA384",
+    "classification": "recommended",
+    "security_bits": 256,
+    "protocol": "TLS",
+    "key_exchange": "any",
+    "authentication": "any",
+    "encryption": "AES 256 GCM",
+    "hash": "SHA384",
+    "tls_versions": [
+      "TLS1.3"
+    ],
+    "quic_allowed": true
+  },
+  {
+    "name": "TLS_CHACHA20_POLY1305_SHA256",
+    "id": "0x1303",
+    "openssl": "TLS_CHACHA20_POLY1305_SHA256",
+    "classification": "recommended",
+    "security_bits": 256,
+    "protocol": "TLS",
+    "key_exchange": "any",
+    "authentication": "any",
+    "encryption": "CHACHA20 POLY1305",
+    "hash": "SHA256",
+    "tls_versions": [
+      "TLS1.3"
+    ],
+    "quic_allowed": true
+  }
+]
+`,
+		},
+		"rejects unknown classification": {
+			args:    []string{"-min", "excellent"},
+			wantErr: true,
+		},
+		"rejects arguments": {
+			args:    []string{"TLS_AES_128_GCM_SHA256"},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+			err := runList(tt.args, &stdout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if got := stdout.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("mismatch:\n  got:\n%s\n  want:\n%s", got, tt.want)
+			}
+		})
+	}
+}
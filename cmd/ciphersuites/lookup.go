@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// suiteInfo describes a cipher suite in the output of the lookup, list and
+// explain commands.
+type suiteInfo struct {
+	Name           string   `json:"name"`
+	ID             string   `json:"id,omitempty"`
+	OpenSSL        string   `json:"openssl,omitempty"`
+	Classification string   `json:"classification"`
+	SecurityBits   int      `json:"security_bits"`
+	Protocol       string   `json:"protocol"`
+	KeyExchange    string   `json:"key_exchange"`
+	Authentication string   `json:"authentication"`
+	Encryption     string   `json:"encryption"`
+	Hash           string   `json:"hash"`
+	TLSVersions    []string `json:"tls_versions"`
+	QUICAllowed    bool     `json:"quic_allowed"`
+	Deprecated     string   `json:"deprecated,omitempty"`
+	Removed        string   `json:"removed,omitempty"`
+	Sources        []string `json:"sources,omitempty"`
+	Attacks        []string `json:"attacks,omitempty"`
+}
+
+func newSuiteInfo(cs ciphersuites.CipherSuite) suiteInfo {
+	info := suiteInfo{
+		Name:           cs.Name,
+		Classification: cs.Classification.String(),
+		SecurityBits:   cs.SecurityBits,
+		Protocol:       cs.ProtocolVersion,
+		KeyExchange:    cs.KeyExchange(),
+		Authentication: cs.Authentication(),
+		Encryption:     cs.EncryptionAlgorithm,
+		Hash:           cs.HashAlgorithm,
+		TLSVersions:    cs.NegotiableVersions(),
+		QUICAllowed:    cs.QUICAllowed(),
+	}
+	if id, ok := ciphersuites.CipherSuiteID(cs.Name); ok {
+		info.ID = fmt.Sprintf("0x%04X", id)
+	}
+	if name, ok := ciphersuites.OpenSSLName(cs.Name); ok {
+		info.OpenSSL = name
+	}
+	if d := cs.Deprecation; d != nil {
+		info.Deprecated = d.Since.Format("2006-01-02")
+		if !d.Removal.IsZero() {
+			info.Removed = d.Removal.Format("2006-01-02")
+		}
+		info.Sources = d.Sources
+	}
+	for _, attack := range cs.Attacks() {
+		info.Attacks = append(info.Attacks, attack.Name)
+	}
+	return info
+}
+
+// resolve looks up a cipher suite given in any form accepted by
+// [ciphersuites.ParseCipherSuite].
+func resolve(s string) (ciphersuites.CipherSuite, error) {
+	name, err := ciphersuites.ParseCipherSuite(s)
+	if err != nil {
+		return ciphersuites.CipherSuite{}, err
+	}
+	cs, ok := ciphersuites.GetCipherSuite(name)
+	if !ok {
+		return ciphersuites.CipherSuite{}, fmt.Errorf("cipher suite %s is not classified", name)
+	}
+	return cs, nil
+}
+
+func runLookup(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("lookup", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites lookup [flags] <name|id|openssl-name>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("no cipher suites given")
+	}
+
+	var infos []suiteInfo
+	for _, arg := range flags.Args() {
+		cs, err := resolve(arg)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, newSuiteInfo(cs))
+	}
+
+	switch *format {
+	case "table":
+		return writeLookupTable(stdout, infos)
+	case "json":
+		return writeJSON(stdout, infos)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func writeLookupTable(w io.Writer, infos []suiteInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, info := range infos {
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintf(tw, "Name:\t%s\n", info.Name)
+		fmt.Fprintf(tw, "ID:\t%s\n", orDash(info.ID))
+		fmt.Fprintf(tw, "OpenSSL:\t%s\n", orDash(info.OpenSSL))
+		fmt.Fprintf(tw, "Classification:\t%s\n", info.Classification)
+		fmt.Fprintf(tw, "Security bits:\t%d\n", info.SecurityBits)
+		fmt.Fprintf(tw, "Key exchange:\t%s\n", info.KeyExchange)
+		fmt.Fprintf(tw, "Authentication:\t%s\n", info.Authentication)
+		fmt.Fprintf(tw, "Encryption:\t%s\n", info.Encryption)
+		fmt.Fprintf(tw, "Hash:\t%s\n", info.Hash)
+		fmt.Fprintf(tw, "TLS versions:\t%s\n", strings.Join(info.TLSVersions, ", "))
+		fmt.Fprintf(tw, "QUIC:\t%s\n", allowed(info.QUICAllowed))
+		fmt.Fprintf(tw, "Deprecated:\t%s\n", orDash(info.Deprecated))
+		fmt.Fprintf(tw, "Removed:\t%s\n", orDash(info.Removed))
+		fmt.Fprintf(tw, "Attacks:\t%s\n", orDash(strings.Join(info.Attacks, ", ")))
+	}
+	return tw.Flush()
+}
+
+func allowed(ok bool) string {
+	if ok {
+		return "allowed"
+	}
+	return "not allowed"
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
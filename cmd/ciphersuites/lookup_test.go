@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		"looks up by identifier": {
+			args: []string{"0x1301"},
+			want: `Name:            TLS_AES_128_GCM_SHA256
+ID:              0x1301
+OpenSSL:         TLS_AES_128_GCM_SHA256
+Classification:  recommended
+Security bits:   128
+Key exchange:    any
+Authentication:  any
+Encryption:      AES 128 GCM
+Hash:            SHA256
+TLS versions:    TLS1.3
+QUIC:            allowed
+Deprecated:      -
+Removed:         -
+Attacks:         -
+`,
+		},
+		"looks up by openssl name as json": {
+			args: []string{"-format", "json", "ECDHE-RSA-AES128-GCM-SHA256"},
+			want: `[
+  {
+    "name": "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+    "id": "0xC02F",
+    "openssl": "ECDHE-RSA-AES128-GCM-SHA256",
+    "classification": "recommended",
+    "security_bits": 128,
+    "protocol": "TLS",
+    "key_exchange": "ECDHE",
+    "authentication": "RSA",
+    "encryption": "AES 128 GCM",
+    "hash": "SHA256",
+    "tls_versions": [
+      "TLS1.2"
+    ],
+    "quic_allowed": false
+  }
+]
+`,
+		},
+		"rejects unknown cipher suite": {
+			args:    []string{"TLS_EXAMPLE_UNASSIGNED"},
+			wantErr: true,
+		},
+		"requires a cipher suite": {
+			args:    []string{},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+			err := runLookup(tt.args, &stdout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if got := stdout.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("mismatch:\n  got:\n%s\n  want:\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Ciphersuites inspects TLS cipher suites and their security classification.
+//
+// Usage:
+//
+//	go run github.com/tomasbasham/ciphersuites/cmd/ciphersuites <command> [flags]
+//
+// Commands:
+//
+//	cert    classify a certificate chain and the cipher suites it can serve
+//	compare report the security impact of changing a cipher suite list
+//	explain describe a cipher suite and why it is classified as it is
+//	list    list cipher suites matching a classification, strength, version or key exchange
+//	lookup  show a cipher suite given its IANA name, identifier or OpenSSL name
+//	order   determine the cipher suite preference order of a TLS server
+//	pcap    report the cipher suites negotiated in packet captures
+//	scan    enumerate the cipher suites accepted by a TLS server
+//	ssh     classify the algorithms of an SSH configuration or key exchange
+//
+// Run a command with -h to list its flags.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string, stdout io.Writer) error
+}
+
+var commands = []command{
+	{"cert", "classify a certificate chain and the cipher suites it can serve", runCert},
+	{"compare", "report the security impact of changing a cipher suite list", runCompare},
+	{"explain", "describe a cipher suite and why it is classified as it is", runExplain},
+	{"list", "list cipher suites matching a classification, strength, version or key exchange", runList},
+	{"lookup", "show a cipher suite given its IANA name, identifier or OpenSSL name", runLookup},
+	{"order", "determine the cipher suite preference order of a TLS server", runOrder},
+	{"pcap", "report the cipher suites negotiated in packet captures", runPcap},
+	{"scan", "enumerate the cipher suites accepted by a TLS server", runScan},
+	{"ssh", "classify the algorithms of an SSH configuration or key exchange", runSSH},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		if err := cmd.run(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if name == "-h" || name == "-help" || name == "help" {
+		usage(os.Stdout)
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Error: unknown command %q\n", name)
+	usage(os.Stderr)
+	os.Exit(2)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: ciphersuites <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+}
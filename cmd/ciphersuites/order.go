@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/scan"
+)
+
+// orderReport is the output of the order command.
+type orderReport struct {
+	Address    string         `json:"address"`
+	Versions   []versionOrder `json:"versions"`
+	Inversions []string       `json:"inversions"`
+}
+
+type versionOrder struct {
+	Version      string           `json:"version"`
+	Preference   string           `json:"preference"`
+	CipherSuites []acceptedCipher `json:"cipher_suites"`
+}
+
+func runOrder(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("order", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	options := scanOptions(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites order [flags] <host:port>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("expected exactly one address")
+	}
+
+	opts, err := options()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	result, err := scan.Order(ctx, flags.Arg(0), opts)
+	if err != nil {
+		return fmt.Errorf("failed to probe %s: %w", flags.Arg(0), err)
+	}
+
+	report := orderReport{
+		Address:    result.Address,
+		Versions:   []versionOrder{},
+		Inversions: []string{},
+	}
+	for _, v := range result.Versions {
+		order := versionOrder{
+			Version:      ciphersuites.VersionName(v.Version),
+			Preference:   string(v.Preference),
+			CipherSuites: []acceptedCipher{},
+		}
+		for _, a := range v.CipherSuites {
+			order.CipherSuites = append(order.CipherSuites, acceptedCipher{
+				Version:        order.Version,
+				CipherSuite:    a.Name,
+				Classification: a.Classification.String(),
+			})
+		}
+		report.Versions = append(report.Versions, order)
+	}
+	for _, i := range result.Inversions() {
+		report.Inversions = append(report.Inversions, fmt.Sprintf("%s: %s", ciphersuites.VersionName(i.Preferred.Version), i))
+	}
+
+	switch *format {
+	case "table":
+		return writeOrderTable(stdout, report)
+	case "json":
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	default:
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+}
+
+func writeOrderTable(w io.Writer, report orderReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tPREFERENCE\t#\tCIPHER SUITE\tCLASSIFICATION")
+	for _, v := range report.Versions {
+		for i, a := range v.CipherSuites {
+			position := "-"
+			if v.Preference == string(scan.ServerPreference) {
+				position = fmt.Sprint(i + 1)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", v.Version, v.Preference, position, a.CipherSuite, a.Classification)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(report.Inversions) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w, "\nWarnings:")
+	for _, i := range report.Inversions {
+		fmt.Fprintf(w, "  %s\n", i)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/pcap"
+	"github.com/tomasbasham/ciphersuites/report"
+)
+
+// connectionReport is the per-connection output of the pcap command.
+type connectionReport struct {
+	File           string `json:"file"`
+	ClientIP       string `json:"client_ip"`
+	ClientPort     int    `json:"client_port"`
+	ServerIP       string `json:"server_ip"`
+	ServerPort     int    `json:"server_port"`
+	Protocol       string `json:"protocol"`
+	ServerName     string `json:"server_name,omitempty"`
+	Version        string `json:"version,omitempty"`
+	CipherSuite    string `json:"cipher_suite,omitempty"`
+	Classification string `json:"classification"`
+}
+
+func runPcap(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("pcap", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table, json, sarif, junit, markdown or html")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites pcap [flags] <file>...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("no capture files given")
+	}
+
+	var (
+		reports  []connectionReport
+		findings []report.Finding
+	)
+	for _, file := range flags.Args() {
+		handshakes, err := readCapture(file)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, connectionReports(file, handshakes)...)
+		findings = append(findings, report.FromHandshakes(file, handshakes)...)
+	}
+
+	switch *format {
+	case "table":
+		return writePcapTable(stdout, reports)
+	case "json":
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(reports)
+	default:
+		return report.Write(stdout, *format, findings)
+	}
+}
+
+func readCapture(file string) ([]pcap.Handshake, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	handshakes, err := pcap.ReadHandshakes(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return handshakes, nil
+}
+
+func connectionReports(file string, handshakes []pcap.Handshake) []connectionReport {
+	reports := make([]connectionReport, 0, len(handshakes))
+	for _, h := range handshakes {
+		r := connectionReport{
+			File:           file,
+			ClientIP:       h.Client.IP.String(),
+			ClientPort:     h.Client.Port,
+			ServerIP:       h.Server.IP.String(),
+			ServerPort:     h.Server.Port,
+			Protocol:       "tcp",
+			Classification: ciphersuites.Unknown.String(),
+		}
+		if h.ClientHello != nil {
+			r.ServerName = h.ClientHello.ServerName
+		}
+		if h.Complete() {
+			r.Version = ciphersuites.VersionName(h.Version())
+			r.CipherSuite = fmt.Sprintf("0x%04X", h.CipherSuite())
+			if cs, ok := ciphersuites.GetCipherSuiteByID(h.CipherSuite()); ok {
+				r.CipherSuite = cs.Name
+				r.Classification = cs.Classification.String()
+			}
+		}
+		reports = append(reports, r)
+	}
+
+	return reports
+}
+
+func writePcapTable(w io.Writer, reports []connectionReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CLIENT\tSERVER\tPROTO\tSERVER NAME\tVERSION\tCIPHER SUITE\tCLASSIFICATION")
+
+	counts := make(map[string]int)
+	for _, r := range reports {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			net.JoinHostPort(r.ClientIP, strconv.Itoa(r.ClientPort)),
+			net.JoinHostPort(r.ServerIP, strconv.Itoa(r.ServerPort)),
+			r.Protocol,
+			orDash(r.ServerName),
+			orDash(r.Version),
+			orDash(r.CipherSuite),
+			r.Classification,
+		)
+		counts[r.Classification]++
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nSummary (%d connections):\n", len(reports))
+	return writeHistogram(w, counts)
+}
+
+// writeHistogram prints the number of connections in each classification as a
+// horizontal bar chart.
+func writeHistogram(w io.Writer, counts map[string]int) error {
+	const width = 40
+
+	max := 0
+	for _, n := range counts {
+		if n > max {
+			max = n
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range classifications {
+		n := counts[c.String()]
+		bar := 0
+		if max > 0 {
+			bar = (n*width + max - 1) / max
+		}
+		fmt.Fprintf(tw, "  %s\t%d", c, n)
+		if bar > 0 {
+			fmt.Fprintf(tw, "\t%s", strings.Repeat("#", bar))
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// classifications lists every classification from strongest to weakest.
+var classifications = []ciphersuites.Classification{
+	ciphersuites.Recommended,
+	ciphersuites.Secure,
+	ciphersuites.Weak,
+	ciphersuites.Insecure,
+	ciphersuites.Unknown,
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
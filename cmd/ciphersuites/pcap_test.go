@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPcap(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		"summarises pcap capture": {
+			args: []string{"../../pcap/testdata/handshakes.pcap"},
+			want: `CLIENT            SERVER              PROTO  SERVER NAME           VERSION  CIPHER SUITE                        CLASSIFICATION
+192.0.2.10:50001  198.51.100.20:443   tcp    example.test          TLS1.3   TLS_AES_128_GCM_SHA256              recommended
+192.0.2.10:50002  198.51.100.20:8443  tcp    legacy.example.test   TLS1.2   TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA  weak
+192.0.2.10:50003  198.51.100.20:443   tcp    -                     TLS1.2   TLS_ECDHE_RSA_WITH_RC4_128_SHA      insecure
+192.0.2.10:50005  198.51.100.20:443   tcp    partial.example.test  -        -                                   unknown
+
+Summary (4 connections):
+  recommended  1  ########################################
+  secure       0
+  weak         1  ########################################
+  insecure     1  ########################################
+  unknown      1  ########################################
+`,
+		},
+		"summarises pcapng capture": {
+			args: []string{"../../pcap/testdata/handshakes.pcapng"},
+			want: `CLIENT                SERVER              PROTO  SERVER NAME          VERSION  CIPHER SUITE                        CLASSIFICATION
+[2001:db8::10]:50001  [2001:db8::20]:443  tcp    example.test         TLS1.3   TLS_AES_128_GCM_SHA256              recommended
+[2001:db8::10]:50002  [2001:db8::20]:443  tcp    legacy.example.test  TLS1.2   TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA  weak
+
+Summary (2 connections):
+  recommended  1  ########################################
+  secure       0
+  weak         1  ########################################
+  insecure     0
+  unknown      0
+`,
+		},
+		"scales histogram across captures": {
+			args: []string{"../../pcap/testdata/handshakes.pcap", "../../pcap/testdata/handshakes.pcapng"},
+			want: `CLIENT                SERVER              PROTO  SERVER NAME           VERSION  CIPHER SUITE                        CLASSIFICATION
+192.0.2.10:50001      198.51.100.20:443   tcp    example.test          TLS1.3   TLS_AES_128_GCM_SHA256              recommended
+192.0.2.10:50002      198.51.100.20:8443  tcp    legacy.example.test   TLS1.2   TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA  weak
+192.0.2.10:50003      198.51.100.20:443   tcp    -                     TLS1.2   TLS_ECDHE_RSA_WITH_RC4_128_SHA      insecure
+192.0.2.10:50005      198.51.100.20:443   tcp    partial.example.test  -        -                                   unknown
+[2001:db8::10]:50001  [2001:db8::20]:443  tcp    example.test          TLS1.3   TLS_AES_128_GCM_SHA256              recommended
+[2001:db8::10]:50002  [2001:db8::20]:443  tcp    legacy.example.test   TLS1.2   TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA  weak
+
+Summary (6 connections):
+  recommended  2  ########################################
+  secure       0
+  weak         2  ########################################
+  insecure     1  ####################
+  unknown      1  ####################
+`,
+		},
+		"writes json": {
+			args: []string{"-format", "json", "../../pcap/testdata/handshakes.pcapng"},
+			want: `[
+  {
+    "file": "../../pcap/testdata/handshakes.pcapng",
+    "client_ip": "2001:db8::10",
+    "client_port": 50001,
+    "server_ip": "2001:db8::20",
+    "server_port": 443,
+    "protocol": "tcp",
+    "server_name": "example.test",
+    "version": "TLS1.3",
+    "cipher_suite": "TLS_AES_128_GCM_SHA256",
+    "classification": "recommended"
+  },
+  {
+    "file": "../../pcap/testdata/handshakes.pcapng",
+    "client_ip": "2001:db8::10",
+    "client_port": 50002,
+    "server_ip": "2001:db8::20",
+    "server_port": 443,
+    "protocol": "tcp",
+    "server_name": "legacy.example.test",
+    "version": "TLS1.2",
+    "cipher_suite": "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+    "classification": "weak"
+  }
+]
+`,
+		},
+		"rejects missing capture": {
+			args:    []string{"testdata/missing.pcap"},
+			wantErr: true,
+		},
+		"requires a capture": {
+			args:    []string{},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+			err := runPcap(tt.args, &stdout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if got := stdout.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("mismatch:\n  got:\n%s\n  want:\n%s", got, tt.want)
+			}
+		})
+	}
+}
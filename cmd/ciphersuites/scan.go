@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/report"
+	"github.com/tomasbasham/ciphersuites/scan"
+)
+
+// scanReport is the output of the scan command.
+type scanReport struct {
+	Address        string           `json:"address"`
+	Classification string           `json:"classification"`
+	Versions       []string         `json:"versions"`
+	Accepted       []acceptedCipher `json:"accepted"`
+}
+
+type acceptedCipher struct {
+	Version        string `json:"version"`
+	CipherSuite    string `json:"cipher_suite"`
+	Classification string `json:"classification"`
+}
+
+// scanOptions registers the flags shared by the commands that probe a server,
+// returning a function that builds the scan options once they are parsed.
+func scanOptions(flags *flag.FlagSet) func() (scan.Options, error) {
+	serverName := flags.String("servername", "", "Server name to send in SNI (default host part of address)")
+	startTLS := flags.String("starttls", "", "Negotiate STARTTLS first: smtp, imap or postgres")
+	concurrency := flags.Int("concurrency", 8, "Maximum number of simultaneous connections")
+	timeout := flags.Duration("timeout", 5*time.Second, "Timeout for each connection")
+
+	return func() (scan.Options, error) {
+		protocol, err := scan.ParseStartTLS(*startTLS)
+		if err != nil {
+			return scan.Options{}, err
+		}
+		return scan.Options{
+			ServerName:  *serverName,
+			Concurrency: *concurrency,
+			Timeout:     *timeout,
+			StartTLS:    protocol,
+		}, nil
+	}
+}
+
+func runScan(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("scan", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table, json, sarif, junit, markdown or html")
+	options := scanOptions(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites scan [flags] <host:port>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("expected exactly one address")
+	}
+
+	opts, err := options()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	result, err := scan.Scan(ctx, flags.Arg(0), opts)
+	if err != nil {
+		return fmt.Errorf("failed to scan %s: %w", flags.Arg(0), err)
+	}
+
+	summary := scanReport{
+		Address:        result.Address,
+		Classification: result.Classification.String(),
+		Versions:       []string{},
+		Accepted:       []acceptedCipher{},
+	}
+	for _, v := range result.Versions() {
+		summary.Versions = append(summary.Versions, ciphersuites.VersionName(v))
+	}
+	for _, a := range result.Accepted {
+		summary.Accepted = append(summary.Accepted, acceptedCipher{
+			Version:        ciphersuites.VersionName(a.Version),
+			CipherSuite:    a.Name,
+			Classification: a.Classification.String(),
+		})
+	}
+
+	switch *format {
+	case "table":
+		return writeScanTable(stdout, summary)
+	case "json":
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(summary)
+	default:
+		return report.Write(stdout, *format, report.FromScan(result))
+	}
+}
+
+func writeScanTable(w io.Writer, summary scanReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tCIPHER SUITE\tCLASSIFICATION")
+	for _, a := range summary.Accepted {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", a.Version, a.CipherSuite, a.Classification)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n%s accepts %d version and cipher suite combinations; weakest classification: %s\n",
+		summary.Address, len(summary.Accepted), summary.Classification)
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/tomasbasham/ciphersuites/ssh"
+)
+
+// sshReport is the output of the ssh command.
+type sshReport struct {
+	File           string         `json:"file"`
+	Classification string         `json:"classification"`
+	Algorithms     []sshAlgorithm `json:"algorithms"`
+	Warnings       []string       `json:"warnings"`
+}
+
+type sshAlgorithm struct {
+	Category       string `json:"category"`
+	Name           string `json:"name"`
+	Classification string `json:"classification"`
+	Reason         string `json:"reason"`
+	Line           int    `json:"line,omitempty"`
+}
+
+func runSSH(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("ssh", flag.ContinueOnError)
+	format := flags.String("format", "table", "Output format: table or json")
+	kexinit := flags.Bool("kexinit", false, "Read a captured SSH_MSG_KEXINIT message instead of a configuration file")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: ciphersuites ssh [flags] <file>")
+		fmt.Fprintln(flags.Output())
+		fmt.Fprintln(flags.Output(), "The file is an sshd_config or ssh_config file, or with -kexinit the raw bytes")
+		fmt.Fprintln(flags.Output(), "of a KEXINIT message or the unencrypted packet carrying it.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("expected a single file")
+	}
+
+	var audit *ssh.Audit
+	if *kexinit {
+		data, err := os.ReadFile(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		k, err := ssh.ParseKexInit(data)
+		if err != nil {
+			return err
+		}
+		audit = k.Audit()
+	} else {
+		var err error
+		audit, err = ssh.AuditConfigFile(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+	}
+
+	summary := sshReport{
+		File:           flags.Arg(0),
+		Classification: audit.Classification().String(),
+		Algorithms:     []sshAlgorithm{},
+		Warnings:       audit.Warnings,
+	}
+	for _, alg := range audit.Algorithms {
+		summary.Algorithms = append(summary.Algorithms, sshAlgorithm{
+			Category:       string(alg.Category),
+			Name:           alg.Name,
+			Classification: alg.Classification.String(),
+			Reason:         alg.Reason,
+			Line:           alg.Line,
+		})
+	}
+
+	switch *format {
+	case "table":
+		return writeSSHTable(stdout, summary)
+	case "json":
+		return writeJSON(stdout, summary)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func writeSSHTable(w io.Writer, summary sshReport) error {
+	fmt.Fprintf(w, "File: %s\n", summary.File)
+	fmt.Fprintf(w, "Classification: %s\n\n", summary.Classification)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CATEGORY\tALGORITHM\tCLASSIFICATION\tLINE\tREASON")
+	for _, alg := range summary.Algorithms {
+		line := "-"
+		if alg.Line > 0 {
+			line = strconv.Itoa(alg.Line)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", alg.Category, alg.Name, alg.Classification, line, alg.Reason)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(summary.Warnings) > 0 {
+		fmt.Fprintln(w, "\nWarnings:")
+		for _, warning := range summary.Warnings {
+			fmt.Fprintf(w, "  %s\n", warning)
+		}
+	}
+	return nil
+}
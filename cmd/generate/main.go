@@ -12,14 +12,57 @@
 //	-package string
 //	    Package name for generated code (default "ciphersuites")
 //
+//	-sha256 string
+//	    Expected hex-encoded SHA-256 digest of the registry file. Generation
+//	    fails if the fetched registry does not match
+//
+//	-registry string
+//	    Read the registry from a local copy, such as a snapshot in
+//	    testdata/registry, instead of fetching it. The path is recorded as
+//	    its source
+//
+//	-mirror string
+//	    Comma-separated list of URLs to try, in order, if the IANA registry
+//	    cannot be fetched
+//
+//	-cache string
+//	    Directory in which to cache the fetched registry
+//
+//	-cache-ttl duration
+//	    How long a cached registry is used without revalidating it (default
+//	    24h0m0s)
+//
+//	-attacks-output string
+//	    Output file path for the catalogue of known attacks (default
+//	    "attacks.gen.go")
+//
+//	-json string
+//	    Also write the classification data as a JSON dataset to the given
+//	    path, for loading at runtime with ciphersuites.LoadDataset
+//
+//	-history string
+//	    Directory of dated registry snapshots to generate the classification
+//	    history from, instead of fetching the current registry
+//
+//	-history-output string
+//	    Output file path for the classification history (default
+//	    "history.gen.go")
+//
 // The generated codes will be written to the given output file and formatted
 // using gofmt.
+//
+// Registry snapshots are CSV files in the same format as the IANA registry,
+// named after the date they were taken, such as 2023-06-01.csv.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"time"
 
 	"github.com/tomasbasham/ciphersuites/internal/generator"
 	"github.com/tomasbasham/ciphersuites/internal/iana"
@@ -29,36 +72,81 @@ const ianaURL = "https://www.iana.org/assignments/tls-parameters/tls-parameters-
 
 func main() {
 	var (
-		outputFile  string
-		packageName string
+		outputFile    string
+		jsonFile      string
+		attacksFile   string
+		packageName   string
+		checksum      string
+		registryFile  string
+		mirrors       string
+		cacheDir      string
+		cacheTTL      time.Duration
+		historyDir    string
+		historyOutput string
 	)
 
 	flag.StringVar(&outputFile, "output", "ciphersuites.gen.go", "Output file path")
+	flag.StringVar(&attacksFile, "attacks-output", "attacks.gen.go", "Output file path for the attack catalogue")
+	flag.StringVar(&jsonFile, "json", "", "Output file path for a JSON dataset")
 	flag.StringVar(&packageName, "package", "ciphersuites", "Package name for generated code")
+	flag.StringVar(&checksum, "sha256", "", "Expected SHA-256 digest of the registry file")
+	flag.StringVar(&registryFile, "registry", "", "Local copy of the registry to read instead of fetching it")
+	flag.StringVar(&mirrors, "mirror", "", "Comma-separated list of fallback registry URLs")
+	flag.StringVar(&cacheDir, "cache", "", "Directory in which to cache the fetched registry")
+	flag.DurationVar(&cacheTTL, "cache-ttl", 24*time.Hour, "How long a cached registry is used without revalidating it")
+	flag.StringVar(&historyDir, "history", "", "Directory of dated registry snapshots")
+	flag.StringVar(&historyOutput, "history-output", "history.gen.go", "Output file path for the classification history")
 	flag.Parse()
 
-	if err := run(outputFile, packageName); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fetcher := iana.NewFetcher()
+	fetcher.CacheDir = cacheDir
+	fetcher.CacheTTL = cacheTTL
+	if mirrors != "" {
+		fetcher.Mirrors = strings.Split(mirrors, ",")
+	}
+
+	var err error
+	if historyDir != "" {
+		err = runHistory(historyDir, historyOutput, packageName)
+	} else {
+		err = run(ctx, fetcher, registryFile, outputFile, attacksFile, jsonFile, packageName, checksum)
+	}
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-func run(outputFile, packageName string) error {
-	fmt.Println("Fetching IANA TLS Cipher Suite Registry...")
-	fetcher := iana.NewFetcher()
-	suites, err := fetcher.FetchCipherSuites(ianaURL)
+func run(ctx context.Context, fetcher *iana.Fetcher, registryFile, outputFile, attacksFile, jsonFile, packageName, checksum string) error {
+	var (
+		registry *iana.Registry
+		err      error
+	)
+	if registryFile != "" {
+		fmt.Printf("Reading IANA TLS Cipher Suite Registry from %s...\n", registryFile)
+		registry, err = fetcher.ReadRegistry(registryFile, checksum)
+	} else {
+		fmt.Println("Fetching IANA TLS Cipher Suite Registry...")
+		registry, err = fetcher.FetchRegistry(ctx, ianaURL, checksum)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to fetch cipher suites: %w", err)
 	}
+	if registry.Cached {
+		fmt.Printf("Using cached registry from %s\n", registry.Source)
+	}
 
-	fmt.Printf("Fetched %d cipher suites\n", len(suites))
+	fmt.Printf("Fetched %d cipher suites (SHA-256 %s)\n", len(registry.Suites), registry.SHA256)
 
 	// Group by security level
-	grouped := generator.GroupBySecurityLevel(suites)
+	grouped := generator.GroupBySecurityLevel(registry.Suites)
 
 	// Generate code
 	fmt.Println("Generating Go code...")
-	gen := generator.NewCodeGenerator(packageName, ianaURL)
+	gen := generator.NewCodeGenerator(packageName, registry.Source, registry.SHA256)
 	code, err := gen.Generate(grouped)
 	if err != nil {
 		return fmt.Errorf("failed to generate code: %w", err)
@@ -78,6 +166,22 @@ func run(outputFile, packageName string) error {
 	}
 
 	fmt.Printf("Successfully generated %s\n", outputFile)
+
+	if err := runAttacks(attacksFile, packageName); err != nil {
+		return err
+	}
+
+	if jsonFile != "" {
+		dataset, err := generator.NewJSONGenerator(registry.Source, registry.SHA256).Generate(grouped)
+		if err != nil {
+			return fmt.Errorf("failed to generate dataset: %w", err)
+		}
+		if err := os.WriteFile(jsonFile, dataset, 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Successfully generated %s\n", jsonFile)
+	}
+
 	fmt.Printf("Statistics:\n")
 	for level, suites := range grouped {
 		fmt.Printf("  %s: %d cipher suites\n", level, len(suites))
@@ -85,3 +189,58 @@ func run(outputFile, packageName string) error {
 
 	return nil
 }
+
+func runHistory(historyDir, outputFile, packageName string) error {
+	fmt.Println("Loading registry snapshots...")
+	snapshots, err := iana.LoadSnapshots(historyDir)
+	if err != nil {
+		return fmt.Errorf("failed to load snapshots: %w", err)
+	}
+
+	fmt.Printf("Loaded %d snapshots\n", len(snapshots))
+
+	// Generate code
+	fmt.Println("Generating Go code...")
+	gen := generator.NewHistoryGenerator(packageName)
+	code, err := gen.Generate(snapshots)
+	if err != nil {
+		return fmt.Errorf("failed to generate code: %w", err)
+	}
+
+	// Format code
+	fmt.Println("Formatting generated code...")
+	formatter := generator.NewFormatter()
+	code, err = formatter.Format(code)
+	if err != nil {
+		return fmt.Errorf("failed to format code: %w", err)
+	}
+
+	// Write to file
+	if err := os.WriteFile(outputFile, code, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Printf("Successfully generated %s\n", outputFile)
+	return nil
+}
+
+func runAttacks(outputFile, packageName string) error {
+	gen := generator.NewAttackGenerator(packageName)
+	code, err := gen.Generate(iana.NewAttackCatalogue().Attacks())
+	if err != nil {
+		return fmt.Errorf("failed to generate attack catalogue: %w", err)
+	}
+
+	formatter := generator.NewFormatter()
+	code, err = formatter.Format(code)
+	if err != nil {
+		return fmt.Errorf("failed to format attack catalogue: %w", err)
+	}
+
+	if err := os.WriteFile(outputFile, code, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Printf("Successfully generated %s\n", outputFile)
+	return nil
+}
@@ -0,0 +1,227 @@
+package ciphersuites
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ParseCipherList resolves a list of cipher suites to their IANA names. The
+// list may be separated by colons, as in an OpenSSL cipher string, or by
+// commas or whitespace, and each entry may be anything accepted by
+// [ParseCipherSuite].
+//
+// Entries prefixed with "!" or "-" remove a cipher suite added earlier in the
+// list, and entries prefixed with "+" move it to the end, as in OpenSSL.
+// OpenSSL keywords that select groups of cipher suites, such as HIGH or
+// aNULL, are not supported because their meaning depends on the OpenSSL
+// build.
+func ParseCipherList(s string) ([]string, error) {
+	// Identifiers written as two bytes contain a comma of their own.
+	s = twoByteID.ReplaceAllString(s, "$1$2")
+
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ':' || r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	var names []string
+	for _, field := range fields {
+		op := field[0]
+		switch op {
+		case '!', '-', '+':
+			field = field[1:]
+		default:
+			op = 0
+		}
+
+		name, err := ParseCipherSuite(field)
+		if err != nil {
+			return nil, err
+		}
+
+		names = removeName(names, name)
+		switch op {
+		case '!', '-':
+		default:
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// twoByteID matches an identifier written as two bytes, such as 0xC0,0x2F,
+// capturing the digits of each byte.
+var twoByteID = regexp.MustCompile(`(0[xX][0-9A-Fa-f]{2})\s*,\s*0[xX]([0-9A-Fa-f]{2})\b`)
+
+func removeName(names []string, name string) []string {
+	for i, n := range names {
+		if n == name {
+			return append(names[:i], names[i+1:]...)
+		}
+	}
+	return names
+}
+
+// Direction describes whether a property of a configuration got better or
+// worse.
+type Direction string
+
+// Directions of change between two configurations.
+const (
+	Improved  Direction = "improved"
+	Regressed Direction = "regressed"
+	Unchanged Direction = "unchanged"
+)
+
+// ConfigurationDiff describes the security impact of changing the cipher
+// suites enabled in a configuration.
+type ConfigurationDiff struct {
+	// Added and Removed list the cipher suites enabled only after and only
+	// before the change, respectively, in the order they were given.
+	// Cipher suites without a classification are reported as [Unknown].
+	Added   []CipherSuite
+	Removed []CipherSuite
+
+	// WeakestBefore and WeakestAfter are the classifications of the weakest
+	// enabled cipher suite, or [Unknown] if no enabled cipher suite has a
+	// classification. Weakest reports how the weakest cipher suite changed; a
+	// configuration without classified cipher suites has nothing weak enabled
+	// and so ranks above any other.
+	WeakestBefore Classification
+	WeakestAfter  Classification
+	Weakest       Direction
+
+	// UnclassifiedBefore and UnclassifiedAfter list the enabled cipher suites
+	// that have no classification, in the order they were given. They are not
+	// taken into account by Weakest.
+	UnclassifiedBefore []string
+	UnclassifiedAfter  []string
+
+	// VersionsGained and VersionsLost list the protocol versions that are,
+	// respectively, only or no longer covered by an enabled cipher suite.
+	VersionsGained []string
+	VersionsLost   []string
+}
+
+// Changed reports whether the configurations enable different cipher suites.
+func (d ConfigurationDiff) Changed() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0
+}
+
+// DiffConfigurations compares the cipher suites enabled before and after a
+// change, given as IANA names such as those returned by [ParseCipherList].
+func DiffConfigurations(before, after []string) ConfigurationDiff {
+	d := ConfigurationDiff{
+		Added:              difference(after, before),
+		Removed:            difference(before, after),
+		WeakestBefore:      weakest(before),
+		WeakestAfter:       weakest(after),
+		UnclassifiedBefore: unclassified(before),
+		UnclassifiedAfter:  unclassified(after),
+		VersionsGained:     versionDifference(after, before),
+		VersionsLost:       versionDifference(before, after),
+	}
+
+	switch {
+	case weakestRank(d.WeakestAfter) > weakestRank(d.WeakestBefore):
+		d.Weakest = Improved
+	case weakestRank(d.WeakestAfter) < weakestRank(d.WeakestBefore):
+		d.Weakest = Regressed
+	default:
+		d.Weakest = Unchanged
+	}
+	return d
+}
+
+// String summarises the diff on a single line.
+func (d ConfigurationDiff) String() string {
+	return fmt.Sprintf("%d added, %d removed, weakest %s (%s to %s)",
+		len(d.Added), len(d.Removed), d.Weakest, d.WeakestBefore, d.WeakestAfter)
+}
+
+// difference returns the cipher suites in a but not in b.
+func difference(a, b []string) []CipherSuite {
+	exclude := make(map[string]bool, len(b))
+	for _, name := range b {
+		exclude[name] = true
+	}
+
+	var suites []CipherSuite
+	for _, name := range a {
+		if !exclude[name] {
+			exclude[name] = true
+			suites = append(suites, lookup(name))
+		}
+	}
+	return suites
+}
+
+// weakest returns the weakest classification among the named cipher suites
+// that have one, or Unknown if none do.
+func weakest(names []string) Classification {
+	weakest := Unknown
+	for _, name := range names {
+		c := GetClassification(name)
+		if c != Unknown && (weakest == Unknown || c.rank() < weakest.rank()) {
+			weakest = c
+		}
+	}
+	return weakest
+}
+
+// weakestRank ranks the weakest classification of a configuration. Unknown,
+// meaning that no classified cipher suite is enabled, ranks above
+// Recommended.
+func weakestRank(c Classification) int {
+	if c == Unknown {
+		return Recommended.rank() + 1
+	}
+	return c.rank()
+}
+
+// unclassified returns the named cipher suites that have no classification.
+func unclassified(names []string) []string {
+	var unknown []string
+	for _, name := range names {
+		if GetClassification(name) == Unknown {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// versionDifference returns the protocol versions covered by a but not by b,
+// in alphabetical order. A cipher suite covers the versions it can be
+// negotiated at, as reported by [CipherSuite.NegotiableVersions].
+func versionDifference(a, b []string) []string {
+	covered := versions(b)
+
+	var gained []string
+	for v := range versions(a) {
+		if !covered[v] {
+			gained = append(gained, v)
+		}
+	}
+	sort.Strings(gained)
+	return gained
+}
+
+func versions(names []string) map[string]bool {
+	versions := make(map[string]bool)
+	for _, name := range names {
+		for _, v := range lookup(name).NegotiableVersions() {
+			versions[v] = true
+		}
+	}
+	return versions
+}
+
+// lookup returns the named cipher suite, or an unclassified cipher suite with
+// only its name set if it is not known.
+func lookup(name string) CipherSuite {
+	if cs, ok := GetCipherSuite(name); ok {
+		return cs
+	}
+	return CipherSuite{Name: name}
+}
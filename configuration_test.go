@@ -0,0 +1,146 @@
+package ciphersuites_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestParseCipherList(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		list    string
+		want    []string
+		wantErr bool
+	}{
+		"parses OpenSSL string": {
+			list: "ECDHE-RSA-AES128-GCM-SHA256:ECDHE-RSA-AES256-GCM-SHA384",
+			want: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+		},
+		"parses mixed forms": {
+			list: "TLS_AES_128_GCM_SHA256, 0xC02F 0xC0,0x30",
+			want: []string{"TLS_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+		},
+		"removes excluded": {
+			list: "AES128-SHA:RC4-SHA:!RC4-SHA",
+			want: []string{"TLS_RSA_WITH_AES_128_CBC_SHA"},
+		},
+		"moves to end": {
+			list: "AES128-SHA:AES256-SHA:+AES128-SHA",
+			want: []string{"TLS_RSA_WITH_AES_256_CBC_SHA", "TLS_RSA_WITH_AES_128_CBC_SHA"},
+		},
+		"ignores duplicates": {
+			list: "AES128-SHA:AES128-SHA",
+			want: []string{"TLS_RSA_WITH_AES_128_CBC_SHA"},
+		},
+		"rejects keywords": {
+			list:    "HIGH:!aNULL",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ciphersuites.ParseCipherList(tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffConfigurations(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		before      []string
+		after       []string
+		wantAdded   []string
+		wantRemoved []string
+		wantWeakest ciphersuites.Direction
+		wantUnknown []string
+		wantGained  []string
+		wantLost    []string
+	}{
+		"removing a weak cipher suite improves": {
+			before:      []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA"},
+			after:       []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			wantRemoved: []string{"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA"},
+			wantWeakest: ciphersuites.Improved,
+			wantLost:    []string{"TLS1.0", "TLS1.1"},
+		},
+		"adding an insecure cipher suite regresses": {
+			before:      []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			after:       []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_RSA_WITH_RC4_128_SHA"},
+			wantAdded:   []string{"TLS_RSA_WITH_RC4_128_SHA"},
+			wantWeakest: ciphersuites.Regressed,
+			wantGained:  []string{"TLS1.0", "TLS1.1"},
+		},
+		"reordering is unchanged": {
+			before:      []string{"TLS_AES_128_GCM_SHA256", "TLS_AES_256_GCM_SHA384"},
+			after:       []string{"TLS_AES_256_GCM_SHA384", "TLS_AES_128_GCM_SHA256"},
+			wantWeakest: ciphersuites.Unchanged,
+		},
+		"removing a tls 1.3 cipher suite loses tls 1.3": {
+			before:      []string{"TLS_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			after:       []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			wantRemoved: []string{"TLS_AES_128_GCM_SHA256"},
+			wantWeakest: ciphersuites.Unchanged,
+			wantLost:    []string{"TLS1.3"},
+		},
+		"removing every cipher suite improves": {
+			before:      []string{"TLS_RSA_WITH_AES_128_CBC_SHA"},
+			wantRemoved: []string{"TLS_RSA_WITH_AES_128_CBC_SHA"},
+			wantWeakest: ciphersuites.Improved,
+			wantLost:    []string{"TLS1.0", "TLS1.1", "TLS1.2"},
+		},
+		"unclassified cipher suite is reported but not ranked": {
+			before:      []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			after:       []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_EXAMPLE_UNASSIGNED"},
+			wantAdded:   []string{"TLS_EXAMPLE_UNASSIGNED"},
+			wantWeakest: ciphersuites.Unchanged,
+			wantUnknown: []string{"TLS_EXAMPLE_UNASSIGNED"},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ciphersuites.DiffConfigurations(tt.before, tt.after)
+			if names := suiteNames(got.Added); !reflect.DeepEqual(names, tt.wantAdded) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", names, tt.wantAdded)
+			}
+			if names := suiteNames(got.Removed); !reflect.DeepEqual(names, tt.wantRemoved) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", names, tt.wantRemoved)
+			}
+			if got.Weakest != tt.wantWeakest {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.Weakest, tt.wantWeakest)
+			}
+			if !reflect.DeepEqual(got.UnclassifiedAfter, tt.wantUnknown) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.UnclassifiedAfter, tt.wantUnknown)
+			}
+			if !reflect.DeepEqual(got.VersionsGained, tt.wantGained) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.VersionsGained, tt.wantGained)
+			}
+			if !reflect.DeepEqual(got.VersionsLost, tt.wantLost) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.VersionsLost, tt.wantLost)
+			}
+		})
+	}
+}
+
+func suiteNames(suites []ciphersuites.CipherSuite) []string {
+	var names []string
+	for _, cs := range suites {
+		names = append(names, cs.Name)
+	}
+	return names
+}
@@ -0,0 +1,240 @@
+package ciphersuites
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// datasetVersion is the version of the JSON dataset format understood by
+// [LoadDataset].
+const datasetVersion = 1
+
+// dataset is a complete set of classification data, either compiled into the
+// package or loaded at runtime.
+type dataset struct {
+	source   string
+	checksum string
+	suites   map[string]CipherSuite
+
+	// names maps the identifiers of the cipher suites whose identifier is
+	// known to their names.
+	names map[uint16]string
+}
+
+// nameByID returns the name of the cipher suite with the given identifier.
+func (d *dataset) nameByID(id uint16) (string, bool) {
+	name, ok := d.names[id]
+	return name, ok
+}
+
+// idByName returns the identifier of the named cipher suite, if it is known.
+func (d *dataset) idByName(name string) (uint16, bool) {
+	cs, ok := d.suites[name]
+	if !ok || d.names[cs.ID] != name {
+		return 0, false
+	}
+	return cs.ID, true
+}
+
+// compiledDataset returns the data generated into the package.
+func compiledDataset() *dataset {
+	d := &dataset{
+		source:   datasetSource,
+		checksum: datasetChecksum,
+		suites:   make(map[string]CipherSuite),
+		names:    make(map[uint16]string),
+	}
+	for _, suites := range []map[string]CipherSuite{
+		RecommendedCipherSuites,
+		SecureCipherSuites,
+		WeakCipherSuites,
+		InsecureCipherSuites,
+	} {
+		for name, cs := range suites {
+			d.suites[name] = cs
+			d.names[cs.ID] = name
+		}
+	}
+	return d
+}
+
+// DatasetSource returns the URL of the registry the classification data was
+// generated from or, if it was generated from a local snapshot, the path of
+// that snapshot relative to the module root.
+func DatasetSource() string {
+	return defaultRegistry.Source()
+}
+
+// DatasetChecksum returns the hex-encoded SHA-256 digest of the registry file
+// the classification data was generated from, so that deployed binaries can
+// report exactly which registry content they contain. It is empty if the data
+// was generated before digests were recorded.
+func DatasetChecksum() string {
+	return defaultRegistry.Checksum()
+}
+
+// LoadDataset reads a JSON dataset, as written by the generator's -json flag,
+// and replaces the classification data used by [GetCipherSuite],
+// [GetClassification] and the other package-level lookups. It is equivalent
+// to calling [Registry.Load] on the [DefaultRegistry].
+//
+// The exported maps, such as [RecommendedCipherSuites], always hold the data
+// compiled into the package.
+func LoadDataset(r io.Reader) error {
+	return defaultRegistry.Load(r)
+}
+
+// LoadDatasetFile is like [LoadDataset] but reads the dataset from the named
+// file.
+func LoadDatasetFile(path string) error {
+	return defaultRegistry.LoadFile(path)
+}
+
+// ResetDataset restores the classification data compiled into the package.
+func ResetDataset() {
+	defaultRegistry.data.Store(compiledDataset())
+}
+
+// datasetFile is the JSON representation of a dataset.
+type datasetFile struct {
+	Version      int                  `json:"version"`
+	Source       string               `json:"source"`
+	SHA256       string               `json:"sha256"`
+	CipherSuites []datasetCipherSuite `json:"cipher_suites"`
+}
+
+type datasetCipherSuite struct {
+	ID             string              `json:"id,omitempty"`
+	Name           string              `json:"name"`
+	Protocol       string              `json:"protocol"`
+	Encryption     string              `json:"encryption"`
+	Hash           string              `json:"hash"`
+	Classification string              `json:"classification"`
+	SecurityBits   int                 `json:"security_bits"`
+	TLSVersions    []string            `json:"tls_versions"`
+	Deprecation    *datasetDeprecation `json:"deprecation,omitempty"`
+}
+
+type datasetDeprecation struct {
+	Since   string   `json:"since"`
+	Removal string   `json:"removal,omitempty"`
+	Sources []string `json:"sources"`
+}
+
+// DatasetError is returned by [LoadDataset] when a dataset fails validation.
+// It lists every problem found.
+type DatasetError struct {
+	Problems []string
+}
+
+func (e *DatasetError) Error() string {
+	return "invalid dataset: " + strings.Join(e.Problems, "; ")
+}
+
+func readDataset(r io.Reader) (*dataset, error) {
+	var file datasetFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid dataset: %w", err)
+	}
+
+	var problems []string
+	addProblem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if file.Version != datasetVersion {
+		addProblem("unsupported version %d", file.Version)
+	}
+	if len(file.CipherSuites) == 0 {
+		addProblem("no cipher suites")
+	}
+
+	d := &dataset{
+		source:   file.Source,
+		checksum: file.SHA256,
+		suites:   make(map[string]CipherSuite, len(file.CipherSuites)),
+		names:    make(map[uint16]string, len(file.CipherSuites)),
+	}
+	for i, s := range file.CipherSuites {
+		if s.Name == "" {
+			addProblem("cipher suite %d has no name", i)
+			continue
+		}
+		if _, ok := d.suites[s.Name]; ok {
+			addProblem("%s is listed more than once", s.Name)
+			continue
+		}
+
+		if s.SecurityBits < 0 {
+			addProblem("%s has negative security bits %d", s.Name, s.SecurityBits)
+		}
+
+		var id uint16
+		if s.ID != "" {
+			v, err := strconv.ParseUint(s.ID, 0, 16)
+			switch other, ok := d.names[uint16(v)]; {
+			case err != nil:
+				addProblem("%s has invalid id %q", s.Name, s.ID)
+			case ok:
+				addProblem("%s shares id %s with %s", s.Name, s.ID, other)
+			default:
+				id = uint16(v)
+				d.names[id] = s.Name
+			}
+		}
+
+		classification, err := ParseClassification(s.Classification)
+		if err != nil || classification == Unknown {
+			addProblem("%s has unknown classification %q", s.Name, s.Classification)
+		}
+
+		cs := CipherSuite{
+			ID:                  id,
+			Name:                s.Name,
+			ProtocolVersion:     s.Protocol,
+			EncryptionAlgorithm: s.Encryption,
+			HashAlgorithm:       s.Hash,
+			Classification:      classification,
+			SecurityBits:        s.SecurityBits,
+			TLSVersions:         s.TLSVersions,
+		}
+		if s.Deprecation != nil {
+			deprecation, err := s.Deprecation.parse()
+			if err != nil {
+				addProblem("%s has %v", s.Name, err)
+			}
+			cs.Deprecation = deprecation
+		}
+		d.suites[s.Name] = cs
+	}
+
+	if len(problems) > 0 {
+		return nil, &DatasetError{Problems: problems}
+	}
+	return d, nil
+}
+
+func (d datasetDeprecation) parse() (*Deprecation, error) {
+	since, err := time.Parse("2006-01-02", d.Since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deprecation date %q", d.Since)
+	}
+
+	deprecation := &Deprecation{Since: since, Sources: d.Sources}
+	if d.Removal != "" {
+		removal, err := time.Parse("2006-01-02", d.Removal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid removal date %q", d.Removal)
+		}
+		if removal.Before(since) {
+			return nil, errors.New("removal date before deprecation date")
+		}
+		deprecation.Removal = removal
+	}
+	return deprecation, nil
+}
@@ -0,0 +1,128 @@
+package ciphersuites_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestDatasetProvenance(t *testing.T) {
+	t.Parallel()
+
+	source := ciphersuites.DatasetSource()
+	if !strings.HasPrefix(source, "https://") && !strings.HasPrefix(source, "testdata/registry/") {
+		t.Errorf("mismatch:\n  got:  %q\n  want: an https URL or a registry snapshot", source)
+	}
+
+	checksum := ciphersuites.DatasetChecksum()
+	if b, err := hex.DecodeString(checksum); err != nil || len(b) != 32 {
+		t.Errorf("mismatch:\n  got:  %q\n  want: a hex-encoded SHA-256 digest", checksum)
+	}
+
+	// A dataset generated from a snapshot must record that snapshot's digest.
+	if strings.HasPrefix(source, "testdata/registry/") {
+		body, err := os.ReadFile(source)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := fmt.Sprintf("%x", sha256.Sum256(body)); got != checksum {
+			t.Errorf("mismatch:\n  got:  %s\n  want: %s", got, checksum)
+		}
+	}
+}
+
+// TestLoadDataset does not run in parallel because it replaces the data used
+// by every other test.
+func TestLoadDataset(t *testing.T) {
+	defer ciphersuites.ResetDataset()
+
+	dataset := `{
+  "version": 1,
+  "source": "https://example.com/tls-parameters-4.csv",
+  "sha256": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+  "cipher_suites": [
+    {
+      "id": "0x1301",
+      "name": "TLS_AES_128_GCM_SHA256",
+      "protocol": "TLS",
+      "encryption": "AES 128 GCM",
+      "hash": "SHA256",
+      "classification": "weak",
+      "tls_versions": ["TLS1.3"],
+      "deprecation": {"since": "2030-01-01", "sources": ["Example"]}
+    }
+  ]
+}`
+	if err := ciphersuites.LoadDataset(strings.NewReader(dataset)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := ciphersuites.GetClassification("TLS_AES_128_GCM_SHA256"); got != ciphersuites.Weak {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Weak)
+	}
+	if id, _ := ciphersuites.CipherSuiteID("TLS_AES_128_GCM_SHA256"); id != 0x1301 {
+		t.Errorf("mismatch:\n  got:  %#04x\n  want: %#04x", id, 0x1301)
+	}
+	if _, ok := ciphersuites.GetCipherSuite("TLS_AES_256_GCM_SHA384"); ok {
+		t.Error("mismatch:\n  got:  found\n  want: not found")
+	}
+	if got := ciphersuites.DatasetSource(); got != "https://example.com/tls-parameters-4.csv" {
+		t.Errorf("mismatch:\n  got:  %q\n  want: the loaded source", got)
+	}
+
+	ciphersuites.ResetDataset()
+	if got := ciphersuites.GetClassification("TLS_AES_128_GCM_SHA256"); got != ciphersuites.Recommended {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Recommended)
+	}
+}
+
+func TestLoadDatasetInvalid(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		dataset string
+	}{
+		"malformed JSON": {
+			dataset: `{"version": 1,`,
+		},
+		"unsupported version": {
+			dataset: `{"version": 2, "cipher_suites": [{"name": "TLS_AES_128_GCM_SHA256", "classification": "recommended"}]}`,
+		},
+		"no cipher suites": {
+			dataset: `{"version": 1, "cipher_suites": []}`,
+		},
+		"unknown classification": {
+			dataset: `{"version": 1, "cipher_suites": [{"name": "TLS_AES_128_GCM_SHA256", "classification": "excellent"}]}`,
+		},
+		"duplicate cipher suite": {
+			dataset: `{"version": 1, "cipher_suites": [
+				{"name": "TLS_AES_128_GCM_SHA256", "classification": "recommended"},
+				{"name": "TLS_AES_128_GCM_SHA256", "classification": "weak"}
+			]}`,
+		},
+		"invalid id": {
+			dataset: `{"version": 1, "cipher_suites": [{"id": "0x13,0x01", "name": "TLS_AES_128_GCM_SHA256", "classification": "recommended"}]}`,
+		},
+		"invalid deprecation date": {
+			dataset: `{"version": 1, "cipher_suites": [{"name": "TLS_AES_128_GCM_SHA256", "classification": "recommended", "deprecation": {"since": "soon"}}]}`,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := ciphersuites.LoadDataset(strings.NewReader(tt.dataset)); err == nil {
+				t.Fatal("mismatch:\n  got:  nil\n  want: an error")
+			}
+			if got := ciphersuites.GetClassification("TLS_AES_128_GCM_SHA256"); got != ciphersuites.Recommended {
+				t.Errorf("mismatch:\n  got:  %v\n  want: the compiled classification", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ciphersuites
+
+import "time"
+
+// Deprecation records when a cipher suite was deprecated, and when support for
+// it was or will be removed, as drawn from RFCs, from browser, library and
+// language runtime announcements, and from the transition schedules of
+// standards bodies such as NIST.
+type Deprecation struct {
+	// Since is the date the cipher suite was first deprecated.
+	Since time.Time
+
+	// Removal is the earliest date on which a major implementation removed,
+	// or a standards body disallows, support for the cipher suite. It may be
+	// in the future for planned removals, and is zero if no removal has been
+	// announced.
+	Removal time.Time
+
+	// Sources cites the documents and announcements behind the dates.
+	Sources []string
+}
+
+// IsDeprecated reports whether the cipher suite was deprecated on or before
+// date.
+func (a CipherSuite) IsDeprecated(date time.Time) bool {
+	return a.Deprecation != nil && !a.Deprecation.Since.After(date)
+}
+
+// IsRemoved reports whether support for the cipher suite was removed from a
+// major implementation on or before date.
+func (a CipherSuite) IsRemoved(date time.Time) bool {
+	return a.Deprecation != nil && !a.Deprecation.Removal.IsZero() && !a.Deprecation.Removal.After(date)
+}
+
+// DeprecatedBy returns the names of the cipher suites that were, or will have
+// been, deprecated on or before date, in alphabetical order. Passing a future
+// date lists the cipher suites scheduled for deprecation by then.
+func DeprecatedBy(date time.Time) []string {
+	return defaultRegistry.DeprecatedBy(date)
+}
+
+// RemovedBy returns the names of the cipher suites whose support was, or will
+// have been, removed from a major implementation on or before date, in
+// alphabetical order.
+func RemovedBy(date time.Time) []string {
+	return defaultRegistry.RemovedBy(date)
+}
@@ -0,0 +1,116 @@
+package ciphersuites_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestDeprecatedBy(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		date    time.Time
+		want    []string
+		notWant []string
+	}{
+		"includes suites deprecated before date": {
+			date:    time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:    []string{"TLS_RSA_WITH_RC4_128_SHA", "TLS_RSA_WITH_3DES_EDE_CBC_SHA", "TLS_RSA_WITH_IDEA_CBC_SHA"},
+			notWant: []string{"TLS_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+		},
+		"includes suites deprecated on date": {
+			date: time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"TLS_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDH_ECDSA_WITH_AES_128_GCM_SHA256"},
+		},
+		"includes ephemeral finite-field diffie-hellman suites": {
+			date:    time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+			want:    []string{"TLS_DHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_DHE_PSK_WITH_AES_128_GCM_SHA256"},
+			notWant: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_PSK_WITH_AES_128_GCM_SHA256"},
+		},
+		"includes suites needing tls 1.0 or 1.1": {
+			date: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"TLS_DHE_RSA_WITH_DES_CBC_SHA", "TLS_RSA_EXPORT_WITH_DES40_CBC_SHA"},
+		},
+		"excludes everything before first deprecation": {
+			date:    time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			notWant: []string{"TLS_RSA_WITH_RC4_128_SHA", "TLS_RSA_WITH_DES_CBC_SHA"},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make(map[string]bool)
+			for _, name := range ciphersuites.DeprecatedBy(tt.date) {
+				got[name] = true
+			}
+			for _, name := range tt.want {
+				if !got[name] {
+					t.Errorf("expected %s to be deprecated by %s", name, tt.date.Format("2006-01-02"))
+				}
+			}
+			for _, name := range tt.notWant {
+				if got[name] {
+					t.Errorf("expected %s not to be deprecated by %s", name, tt.date.Format("2006-01-02"))
+				}
+			}
+		})
+	}
+}
+
+func TestCipherSuiteDeprecation(t *testing.T) {
+	t.Parallel()
+
+	cs, ok := ciphersuites.GetCipherSuite("TLS_RSA_WITH_3DES_EDE_CBC_SHA")
+	if !ok {
+		t.Fatal("cipher suite not found")
+	}
+
+	if cs.Deprecation == nil {
+		t.Fatal("expected deprecation metadata")
+	}
+	if !cs.IsDeprecated(time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected 3DES to be deprecated by June 2015")
+	}
+	if cs.IsRemoved(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected 3DES not to be removed by January 2021")
+	}
+	if !cs.IsRemoved(time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected 3DES to be removed by September 2021")
+	}
+
+	recommended, _ := ciphersuites.GetCipherSuite("TLS_AES_128_GCM_SHA256")
+	if recommended.Deprecation != nil {
+		t.Errorf("unexpected deprecation: %+v", recommended.Deprecation)
+	}
+}
+
+func TestPlannedRemoval(t *testing.T) {
+	t.Parallel()
+
+	cs, ok := ciphersuites.GetCipherSuite("TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA")
+	if !ok {
+		t.Fatal("cipher suite not found")
+	}
+
+	if cs.IsRemoved(time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected SHA-1 cipher suites to remain allowed on 31 December 2030")
+	}
+	if !cs.IsRemoved(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected SHA-1 cipher suites to be removed by January 2031")
+	}
+
+	removed := make(map[string]bool)
+	for _, name := range ciphersuites.RemovedBy(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		removed[name] = true
+	}
+	if !removed["TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA"] {
+		t.Error("expected TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA to be removed by January 2031")
+	}
+	if removed["TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384"] {
+		t.Error("expected TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384 not to be removed by January 2031")
+	}
+}
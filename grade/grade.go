@@ -0,0 +1,353 @@
+// Package grade combines the cipher suites and protocol versions enabled on a
+// TLS endpoint into a single letter grade, in the style of the SSL Labs
+// server rating guide.
+//
+// # Scoring
+//
+// Three category scores between 0 and 100 are calculated, each as the average
+// of the best and worst value found among the enabled protocol versions or
+// cipher suites:
+//
+//   - Protocol support: SSL 3.0 scores 80, TLS 1.0 90, TLS 1.1 95, and TLS 1.2
+//     and TLS 1.3 score 100.
+//   - Key exchange: anonymous key exchange scores 0, export key exchange 20,
+//     static RSA and PSK 80, finite field Diffie-Hellman 90, and elliptic
+//     curve Diffie-Hellman and TLS 1.3 score 100.
+//   - Cipher strength, as reported by [ciphersuites.CipherSuite.SecurityBits]:
+//     no security scores 0, fewer than 128 bits 20, fewer than 256 bits 80,
+//     and 256 bits or more 100.
+//
+// The overall score weights them 30%, 30% and 40% respectively. A score of 80
+// or more is an A, 65 a B, 50 a C, 35 a D and 20 an E. Anything lower is an F.
+//
+// # Caps
+//
+// Regardless of score, the grade is capped when:
+//
+//   - any enabled cipher suite is classified insecure (F),
+//   - SSL 3.0 is enabled (F),
+//   - no cipher suite can be classified at all (F),
+//   - neither TLS 1.2 nor TLS 1.3 is enabled (C),
+//   - the server prefers a weaker cipher suite over a stronger one (C),
+//   - TLS 1.0 or TLS 1.1 is enabled (B),
+//   - any enabled cipher suite is classified weak (B),
+//   - no cipher suite provides forward secrecy (B), or
+//   - no cipher suite uses authenticated encryption (B).
+//
+// An A is raised to an A+ when TLS 1.3 is enabled and every cipher suite is
+// classified recommended.
+package grade
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/scan"
+)
+
+// Grade is a letter grade from A+ (best) to F (worst).
+type Grade string
+
+// Letter grades, from best to worst.
+const (
+	APlus Grade = "A+"
+	A     Grade = "A"
+	B     Grade = "B"
+	C     Grade = "C"
+	D     Grade = "D"
+	E     Grade = "E"
+	F     Grade = "F"
+)
+
+// grades orders the letter grades from best to worst.
+var grades = []Grade{APlus, A, B, C, D, E, F}
+
+func (g Grade) worse(other Grade) bool {
+	return g.index() > other.index()
+}
+
+func (g Grade) index() int {
+	for i, grade := range grades {
+		if grade == g {
+			return i
+		}
+	}
+	return len(grades)
+}
+
+// Endpoint describes the TLS parameters enabled on a server.
+type Endpoint struct {
+	// Versions lists the enabled protocol versions.
+	Versions []uint16
+
+	// CipherSuites lists the enabled cipher suites. TLS 1.3 cipher suites
+	// should be included if TLS 1.3 is enabled.
+	CipherSuites []uint16
+
+	// Preferred, if known, lists the cipher suites in the order the server
+	// prefers them. It should be left empty if the server honours the
+	// client's preference.
+	Preferred []uint16
+}
+
+// FromConfig describes the endpoint a server using cfg would present. The
+// crypto/tls defaults are assumed for any field that is not set.
+func FromConfig(cfg *tls.Config) Endpoint {
+	minVersion, maxVersion := cfg.MinVersion, cfg.MaxVersion
+	if minVersion == 0 {
+		minVersion = tls.VersionTLS12
+	}
+	if maxVersion == 0 {
+		maxVersion = tls.VersionTLS13
+	}
+
+	var e Endpoint
+	for v := minVersion; v <= maxVersion; v++ {
+		e.Versions = append(e.Versions, v)
+	}
+
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		tls13 := suite.SupportedVersions[0] == tls.VersionTLS13
+		configured := len(cfg.CipherSuites) == 0 && !suite.Insecure
+		for _, id := range cfg.CipherSuites {
+			configured = configured || id == suite.ID
+		}
+		if !tls13 && !configured {
+			continue
+		}
+
+		for _, v := range suite.SupportedVersions {
+			if v >= minVersion && v <= maxVersion {
+				e.CipherSuites = append(e.CipherSuites, suite.ID)
+				break
+			}
+		}
+	}
+
+	return e
+}
+
+// FromScan describes the endpoint observed by an active scan.
+func FromScan(result *scan.Result) Endpoint {
+	var e Endpoint
+	e.Versions = result.Versions()
+
+	seen := make(map[uint16]bool)
+	for _, a := range result.Accepted {
+		if !seen[a.CipherSuite] {
+			seen[a.CipherSuite] = true
+			e.CipherSuites = append(e.CipherSuites, a.CipherSuite)
+		}
+	}
+	return e
+}
+
+// Deduction explains how a single property of the endpoint affected a
+// category score.
+type Deduction struct {
+	Category string
+	Points   int
+	Reason   string
+}
+
+// Cap explains a rule that limited the grade.
+type Cap struct {
+	Grade  Grade
+	Reason string
+}
+
+// Report is the result of grading an endpoint.
+type Report struct {
+	Grade Grade
+	Score int
+
+	ProtocolScore    int
+	KeyExchangeScore int
+	CipherScore      int
+
+	// Deductions explain every category score below 100.
+	Deductions []Deduction
+
+	// Caps lists every rule that limited the grade, whether or not it was the
+	// deciding one.
+	Caps []Cap
+}
+
+// Explain describes the grade and every deduction and cap on separate lines.
+func (r Report) Explain() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Grade %s (score %d: protocol %d, key exchange %d, cipher %d)\n",
+		r.Grade, r.Score, r.ProtocolScore, r.KeyExchangeScore, r.CipherScore)
+	for _, d := range r.Deductions {
+		fmt.Fprintf(&b, "  -%d %s: %s\n", d.Points, d.Category, d.Reason)
+	}
+	for _, c := range r.Caps {
+		fmt.Fprintf(&b, "  capped at %s: %s\n", c.Grade, c.Reason)
+	}
+	return b.String()
+}
+
+// Evaluate grades an endpoint.
+func Evaluate(e Endpoint) Report {
+	var r Report
+
+	r.ProtocolScore = r.categoryScore("protocol", e.Versions, func(v uint16) (int, string) {
+		return protocolScore(v), ciphersuites.VersionName(v)
+	})
+
+	suites := classify(append(append([]uint16(nil), e.CipherSuites...), e.Preferred...))
+	r.KeyExchangeScore = r.categoryScore("key exchange", e.CipherSuites, func(id uint16) (int, string) {
+		kx := keyExchange(suites[id].name)
+		return kx.score, fmt.Sprintf("%s uses %s", suites[id].name, kx.name)
+	})
+	r.CipherScore = r.categoryScore("cipher", e.CipherSuites, func(id uint16) (int, string) {
+		bits := suites[id].securityBits
+		return cipherScore(bits), fmt.Sprintf("%s provides %d bits of security", suites[id].name, bits)
+	})
+
+	r.Score = (30*r.ProtocolScore + 30*r.KeyExchangeScore + 40*r.CipherScore + 50) / 100
+	r.Grade = scoreGrade(r.Score)
+
+	r.applyCaps(e, suites)
+	for _, c := range r.Caps {
+		if c.Grade.worse(r.Grade) {
+			r.Grade = c.Grade
+		}
+	}
+
+	if r.Grade == A && r.qualifiesForAPlus(e, suites) {
+		r.Grade = APlus
+	}
+
+	return r
+}
+
+// categoryScore averages the best and worst scores of values, recording a
+// deduction for the worst value if it scored below 100.
+func (r *Report) categoryScore(category string, values []uint16, score func(uint16) (int, string)) int {
+	if len(values) == 0 {
+		r.Deductions = append(r.Deductions, Deduction{category, 100, "nothing enabled"})
+		return 0
+	}
+
+	best, worst := -1, 101
+	var worstReason string
+	for _, v := range values {
+		s, reason := score(v)
+		if s > best {
+			best = s
+		}
+		if s < worst {
+			worst, worstReason = s, reason
+		}
+	}
+
+	total := (best + worst) / 2
+	if total < 100 {
+		r.Deductions = append(r.Deductions, Deduction{category, 100 - total, worstReason})
+	}
+	return total
+}
+
+func (r *Report) addCap(g Grade, format string, args ...interface{}) {
+	r.Caps = append(r.Caps, Cap{Grade: g, Reason: fmt.Sprintf(format, args...)})
+}
+
+func (r *Report) applyCaps(e Endpoint, suites map[uint16]suite) {
+	var (
+		insecure, weak []string
+		known          int
+		forwardSecret  bool
+		aead           bool
+	)
+	for _, id := range e.CipherSuites {
+		s := suites[id]
+		switch s.classification {
+		case ciphersuites.Insecure:
+			insecure = append(insecure, s.name)
+		case ciphersuites.Weak:
+			weak = append(weak, s.name)
+		}
+		if s.classification != ciphersuites.Unknown {
+			known++
+		}
+		forwardSecret = forwardSecret || keyExchange(s.name).forwardSecret
+		aead = aead || isAEAD(s.name)
+	}
+
+	if len(insecure) > 0 {
+		r.addCap(F, "insecure cipher suites enabled: %s", strings.Join(insecure, ", "))
+	}
+	if containsVersion(e.Versions, tls.VersionSSL30) {
+		r.addCap(F, "SSL 3.0 enabled")
+	}
+	if known == 0 {
+		r.addCap(F, "no recognised cipher suites enabled")
+	}
+	if !containsVersion(e.Versions, tls.VersionTLS12) && !containsVersion(e.Versions, tls.VersionTLS13) {
+		r.addCap(C, "neither TLS 1.2 nor TLS 1.3 enabled")
+	}
+	if len(e.Preferred) > 0 {
+		first := suites[e.Preferred[0]]
+		for _, id := range e.Preferred[1:] {
+			if s := suites[id]; !first.classification.AtLeast(s.classification) {
+				r.addCap(C, "server prefers %s %s over %s %s", first.classification, first.name, s.classification, s.name)
+				break
+			}
+		}
+	}
+	for _, v := range []uint16{tls.VersionTLS10, tls.VersionTLS11} {
+		if containsVersion(e.Versions, v) {
+			r.addCap(B, "%s enabled", ciphersuites.VersionName(v))
+		}
+	}
+	if len(weak) > 0 {
+		r.addCap(B, "weak cipher suites enabled: %s", strings.Join(weak, ", "))
+	}
+	if len(e.CipherSuites) > 0 && !forwardSecret {
+		r.addCap(B, "no cipher suite provides forward secrecy")
+	}
+	if len(e.CipherSuites) > 0 && !aead {
+		r.addCap(B, "no cipher suite uses authenticated encryption")
+	}
+}
+
+func (r *Report) qualifiesForAPlus(e Endpoint, suites map[uint16]suite) bool {
+	if !containsVersion(e.Versions, tls.VersionTLS13) {
+		return false
+	}
+	for _, id := range e.CipherSuites {
+		if suites[id].classification != ciphersuites.Recommended {
+			return false
+		}
+	}
+	return true
+}
+
+func scoreGrade(score int) Grade {
+	switch {
+	case score >= 80:
+		return A
+	case score >= 65:
+		return B
+	case score >= 50:
+		return C
+	case score >= 35:
+		return D
+	case score >= 20:
+		return E
+	default:
+		return F
+	}
+}
+
+func containsVersion(versions []uint16, v uint16) bool {
+	for _, version := range versions {
+		if version == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+package grade_test
+
+import (
+	"crypto/tls"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/grade"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	tls13 := []uint16{
+		tls.TLS_AES_128_GCM_SHA256,
+		tls.TLS_AES_256_GCM_SHA384,
+		tls.TLS_CHACHA20_POLY1305_SHA256,
+	}
+
+	var tests = map[string]struct {
+		endpoint grade.Endpoint
+		want     grade.Grade
+		wantCaps int
+	}{
+		"grades modern endpoint A+": {
+			endpoint: grade.Endpoint{
+				Versions: []uint16{tls.VersionTLS12, tls.VersionTLS13},
+				CipherSuites: append([]uint16{
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+				}, tls13...),
+			},
+			want: grade.APlus,
+		},
+		"grades secure endpoint A": {
+			endpoint: grade.Endpoint{
+				Versions:     []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+			},
+			want: grade.A,
+		},
+		"caps TLS 1.0 at B": {
+			endpoint: grade.Endpoint{
+				Versions:     []uint16{tls.VersionTLS10, tls.VersionTLS12},
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+			},
+			want:     grade.B,
+			wantCaps: 1,
+		},
+		"caps weak suite at B": {
+			endpoint: grade.Endpoint{
+				Versions: []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+				},
+			},
+			want:     grade.B,
+			wantCaps: 1,
+		},
+		"caps weak preference at C": {
+			endpoint: grade.Endpoint{
+				Versions: []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+				},
+				Preferred: []uint16{
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+				},
+			},
+			want:     grade.C,
+			wantCaps: 2,
+		},
+		"caps insecure suite at F": {
+			endpoint: grade.Endpoint{
+				Versions: []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{
+					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+					tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA,
+				},
+			},
+			want:     grade.F,
+			wantCaps: 1,
+		},
+		"grades empty endpoint F": {
+			endpoint: grade.Endpoint{},
+			want:     grade.F,
+			wantCaps: 2,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			report := grade.Evaluate(tt.endpoint)
+			if report.Grade != tt.want {
+				t.Errorf("mismatch:\n  got:  %s\n  want: %s\n%s", report.Grade, tt.want, report.Explain())
+			}
+			if len(report.Caps) != tt.wantCaps {
+				t.Errorf("mismatch:\n  got:  %d caps\n  want: %d caps\n%s", len(report.Caps), tt.wantCaps, report.Explain())
+			}
+		})
+	}
+}
+
+func TestEvaluateCipherScore(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite uint16
+		want        int
+	}{
+		"128-bit cipher with SHA-256": {
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+			want:        80,
+		},
+		"256-bit cipher": {
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			want:        100,
+		},
+		"3DES": {
+			cipherSuite: tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
+			want:        20,
+		},
+		"unknown cipher suite": {
+			cipherSuite: 0xFFFF,
+			want:        0,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			report := grade.Evaluate(grade.Endpoint{
+				Versions:     []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{tt.cipherSuite},
+			})
+			if report.CipherScore != tt.want {
+				t.Errorf("mismatch:\n  got:  %d\n  want: %d\n%s", report.CipherScore, tt.want, report.Explain())
+			}
+		})
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	t.Parallel()
+
+	endpoint := grade.FromConfig(&tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+	})
+
+	if len(endpoint.Versions) != 2 {
+		t.Errorf("mismatch:\n  got:  %v\n  want: [TLS1.2 TLS1.3]", endpoint.Versions)
+	}
+	// The configured suite plus the three TLS 1.3 suites.
+	if len(endpoint.CipherSuites) != 4 {
+		t.Errorf("mismatch:\n  got:  %d cipher suites\n  want: 4", len(endpoint.CipherSuites))
+	}
+
+	if report := grade.Evaluate(endpoint); report.Grade != grade.APlus {
+		t.Errorf("mismatch:\n  got:  %s\n  want: %s\n%s", report.Grade, grade.APlus, report.Explain())
+	}
+}
+
+func TestEvaluateForwardSecrecyAndAEAD(t *testing.T) {
+	t.Parallel()
+
+	const (
+		noForwardSecrecy = "no cipher suite provides forward secrecy"
+		noAEAD           = "no cipher suite uses authenticated encryption"
+	)
+
+	var tests = map[string]struct {
+		cipherSuite uint16
+		want        []string
+	}{
+		"ephemeral key exchange with AEAD": {
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		},
+		"fallback signalling value": {
+			cipherSuite: 0x5600,
+			want:        []string{noForwardSecrecy, noAEAD},
+		},
+		"renegotiation signalling value": {
+			cipherSuite: 0x00FF,
+			want:        []string{noForwardSecrecy, noAEAD},
+		},
+		"integrity-only TLS 1.3": {
+			cipherSuite: 0xC0B4,
+			want:        []string{noForwardSecrecy, noAEAD},
+		},
+		"NULL cipher with ephemeral key exchange": {
+			cipherSuite: 0xC010,
+			want:        []string{noForwardSecrecy, noAEAD},
+		},
+		"unknown cipher suite": {
+			cipherSuite: 0xFFFF,
+			want:        []string{noForwardSecrecy, noAEAD},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The static RSA cipher suite provides neither property, so only
+			// the cipher suite under test can.
+			report := grade.Evaluate(grade.Endpoint{
+				Versions:     []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{tls.TLS_RSA_WITH_AES_128_CBC_SHA, tt.cipherSuite},
+			})
+
+			var got []string
+			for _, c := range report.Caps {
+				if c.Reason == noForwardSecrecy || c.Reason == noAEAD {
+					got = append(got, c.Reason)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v\n%s", got, tt.want, report.Explain())
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package grade
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// suite holds the properties of an enabled cipher suite used in grading.
+type suite struct {
+	name           string
+	classification ciphersuites.Classification
+	securityBits   int
+}
+
+func classify(ids []uint16) map[uint16]suite {
+	suites := make(map[uint16]suite, len(ids))
+	for _, id := range ids {
+		s := suite{name: fmt.Sprintf("0x%04X", id), classification: ciphersuites.Unknown}
+		if cs, ok := ciphersuites.GetCipherSuiteByID(id); ok {
+			s = suite{name: cs.Name, classification: cs.Classification, securityBits: cs.SecurityBits}
+		}
+		suites[id] = s
+	}
+	return suites
+}
+
+type kex struct {
+	name          string
+	score         int
+	forwardSecret bool
+}
+
+// keyExchange derives the key exchange method from a cipher suite name.
+// Without knowledge of the server's key sizes, each method is scored by the
+// strength it typically provides.
+func keyExchange(name string) kex {
+	switch {
+	case !strings.HasPrefix(name, "TLS_"):
+		return kex{"unknown key exchange", 0, false}
+	case strings.HasSuffix(name, "_SCSV"):
+		return kex{"no key exchange", 0, false}
+	}
+
+	method := strings.TrimPrefix(name, "TLS_")
+	idx := strings.Index(method, "_WITH_")
+	if idx == -1 {
+		// TLS 1.3 cipher suites do not name a key exchange, which is always
+		// ephemeral. Like the NULL cipher suites below, the integrity-only
+		// cipher suites have no confidentiality for it to protect.
+		return kex{"TLS 1.3 key exchange", 100, !hasNullCipher(name)}
+	}
+	method = method[:idx]
+
+	switch {
+	case strings.Contains(method, "anon") || method == "NULL":
+		return kex{"anonymous key exchange", 0, false}
+	case strings.Contains(method, "EXPORT"):
+		return kex{"export key exchange", 20, false}
+	case strings.HasPrefix(method, "ECDHE"):
+		return kex{"ECDHE key exchange", 100, !hasNullCipher(name)}
+	case strings.HasPrefix(method, "DHE"):
+		return kex{"DHE key exchange", 90, !hasNullCipher(name)}
+	default:
+		return kex{method + " key exchange", 80, false}
+	}
+}
+
+func cipherScore(bits int) int {
+	switch {
+	case bits == 0:
+		return 0
+	case bits < 128:
+		return 20
+	case bits < 256:
+		return 80
+	default:
+		return 100
+	}
+}
+
+func protocolScore(version uint16) int {
+	switch version {
+	case tls.VersionSSL30:
+		return 80
+	case tls.VersionTLS10:
+		return 90
+	case tls.VersionTLS11:
+		return 95
+	case tls.VersionTLS12, tls.VersionTLS13:
+		return 100
+	default:
+		return 0
+	}
+}
+
+func isAEAD(name string) bool {
+	switch {
+	case !strings.HasPrefix(name, "TLS_"), strings.HasSuffix(name, "_SCSV"), hasNullCipher(name):
+		return false
+	case !strings.Contains(name, "_WITH_"):
+		return true
+	}
+	for _, mode := range []string{"GCM", "CCM", "POLY1305", "MGM"} {
+		if strings.Contains(name, mode) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNullCipher reports whether the named cipher suite provides no
+// encryption: a NULL cipher suite, or an integrity-only TLS 1.3 cipher suite
+// such as TLS_SHA256_SHA256.
+func hasNullCipher(name string) bool {
+	if strings.Contains(name, "_WITH_") {
+		return strings.Contains(name, "_WITH_NULL_")
+	}
+	return strings.HasPrefix(name, "TLS_SHA")
+}
@@ -0,0 +1,68 @@
+package handshake
+
+import (
+	"fmt"
+	"io"
+)
+
+// maxRecordLength is the largest record permitted by RFC 8446, including
+// the expansion allowed for protected records.
+const maxRecordLength = 1<<14 + 256
+
+// AlertError is returned when the peer responds with a TLS alert.
+type AlertError struct {
+	Level       uint8
+	Description uint8
+}
+
+func (e *AlertError) Error() string {
+	return fmt.Sprintf("handshake: received alert %d", e.Description)
+}
+
+// ReadServerHello reads records from r until a ServerHello, which may be a
+// HelloRetryRequest, has been received. An [*AlertError] is returned if the
+// server responds with an alert instead.
+func ReadServerHello(r io.Reader) (*ServerHello, error) {
+	var buf []byte
+	header := make([]byte, 5)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, err
+		}
+		if header[1] != 0x03 {
+			return nil, ErrNotTLS
+		}
+
+		length := int(header[3])<<8 | int(header[4])
+		if length > maxRecordLength {
+			return nil, fmt.Errorf("handshake: record length %d exceeds limit", length)
+		}
+		fragment := make([]byte, length)
+		if _, err := io.ReadFull(r, fragment); err != nil {
+			return nil, err
+		}
+
+		switch header[0] {
+		case recordAlert:
+			if len(fragment) < 2 {
+				return nil, ErrTruncated
+			}
+			return nil, &AlertError{Level: fragment[0], Description: fragment[1]}
+		case recordHandshake:
+			buf = append(buf, fragment...)
+		default:
+			return nil, ErrNotTLS
+		}
+
+		if len(buf) < 4 {
+			continue
+		}
+		if Message(buf).Type() != TypeServerHello {
+			return nil, fmt.Errorf("handshake: unexpected message type %d", buf[0])
+		}
+		length = int(buf[1])<<16 | int(buf[2])<<8 | int(buf[3])
+		if len(buf) >= 4+length {
+			return ParseServerHello(Message(buf[:4+length]))
+		}
+	}
+}
@@ -0,0 +1,318 @@
+// Package handshake decodes the TLS handshake messages needed to determine
+// which cipher suites a client offered and a server selected.
+//
+// Only the ClientHello and ServerHello messages are decoded. Everything that
+// follows them is either encrypted (TLS 1.3) or irrelevant to cipher suite
+// negotiation.
+package handshake
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Handshake message types.
+const (
+	TypeClientHello uint8 = 1
+	TypeServerHello uint8 = 2
+)
+
+// TLS record content types.
+const (
+	recordChangeCipherSpec uint8 = 20
+	recordAlert            uint8 = 21
+	recordHandshake        uint8 = 22
+)
+
+// Extension types.
+const (
+	extensionServerName          uint16 = 0
+	extensionSupportedGroups     uint16 = 10
+	extensionECPointFormats      uint16 = 11
+	extensionSignatureAlgorithms uint16 = 13
+	extensionSupportedVersions   uint16 = 43
+	extensionKeyShare            uint16 = 51
+	extensionRenegotiationInfo   uint16 = 0xFF01
+)
+
+// ErrTruncated is returned when a message is shorter than its encoded lengths
+// claim it to be.
+var ErrTruncated = errors.New("handshake: truncated message")
+
+// ErrNotTLS is returned when a byte stream does not begin with a TLS record.
+var ErrNotTLS = errors.New("handshake: not a TLS record stream")
+
+// helloRetryRequestRandom is the special ServerHello random value identifying
+// a HelloRetryRequest in TLS 1.3 (RFC 8446, section 4.1.3).
+var helloRetryRequestRandom = []byte{
+	0xCF, 0x21, 0xAD, 0x74, 0xE5, 0x9A, 0x61, 0x11,
+	0xBE, 0x1D, 0x8C, 0x02, 0x1E, 0x65, 0xB8, 0x91,
+	0xC2, 0xA2, 0x11, 0x16, 0x7A, 0xBB, 0x8C, 0x5E,
+	0x07, 0x9E, 0x09, 0xE2, 0xC8, 0xA8, 0x33, 0x9C,
+}
+
+// Message is a single handshake message including its four byte header.
+type Message []byte
+
+// Type returns the handshake message type.
+func (m Message) Type() uint8 {
+	if len(m) == 0 {
+		return 0
+	}
+	return m[0]
+}
+
+// ReadMessages extracts the plaintext handshake messages from the start of a
+// stream of TLS records sent by one side of a connection.
+//
+// Handshake messages may be fragmented across, or coalesced within, records.
+// Reading stops at the first record that cannot be plaintext handshake data,
+// such as application data or an alert, and any trailing partial message is
+// discarded.
+func ReadMessages(stream []byte) ([]Message, error) {
+	if len(stream) > 0 && stream[0] != recordHandshake {
+		return nil, ErrNotTLS
+	}
+
+	var buf []byte
+	for len(stream) >= 5 {
+		contentType := stream[0]
+		length := int(stream[3])<<8 | int(stream[4])
+		if stream[1] != 0x03 {
+			return nil, ErrNotTLS
+		}
+		if len(stream) < 5+length {
+			buf = append(buf, stream[5:]...)
+			break
+		}
+
+		fragment := stream[5 : 5+length]
+		stream = stream[5+length:]
+
+		if contentType == recordChangeCipherSpec {
+			continue
+		}
+		if contentType != recordHandshake {
+			break
+		}
+		buf = append(buf, fragment...)
+	}
+
+	var messages []Message
+	for len(buf) >= 4 {
+		length := int(buf[1])<<16 | int(buf[2])<<8 | int(buf[3])
+		if len(buf) < 4+length {
+			break
+		}
+		messages = append(messages, Message(buf[:4+length]))
+		buf = buf[4+length:]
+	}
+
+	return messages, nil
+}
+
+// ClientHello holds the negotiation parameters offered by a client.
+type ClientHello struct {
+	// Version is the legacy_version field. Clients offering TLS 1.3 list their
+	// versions in SupportedVersions instead.
+	Version             uint16
+	Random              []byte
+	SessionID           []byte
+	CipherSuites        []uint16
+	ServerName          string
+	SupportedVersions   []uint16
+	SupportedGroups     []uint16
+	SignatureAlgorithms []uint16
+	KeyShares           []KeyShare
+}
+
+// KeyShare is a key exchange share offered in a TLS 1.3 ClientHello.
+type KeyShare struct {
+	Group uint16
+	Data  []byte
+}
+
+// ParseClientHello decodes a ClientHello handshake message, including its
+// four byte header.
+func ParseClientHello(m Message) (*ClientHello, error) {
+	body, err := messageBody(m, TypeClientHello)
+	if err != nil {
+		return nil, err
+	}
+
+	s := reader(body)
+	hello := &ClientHello{}
+
+	var random, suites, compression, extensions reader
+	if !s.readUint16(&hello.Version) ||
+		!s.readN(32, &random) ||
+		!s.readBytes8((*reader)(&hello.SessionID)) ||
+		!s.readBytes16(&suites) ||
+		!s.readBytes8(&compression) {
+		return nil, ErrTruncated
+	}
+	hello.Random = random
+
+	for !suites.empty() {
+		var suite uint16
+		if !suites.readUint16(&suite) {
+			return nil, ErrTruncated
+		}
+		hello.CipherSuites = append(hello.CipherSuites, suite)
+	}
+
+	// Extensions are optional in TLS 1.2 and earlier.
+	if s.empty() {
+		return hello, nil
+	}
+	if !s.readBytes16(&extensions) {
+		return nil, ErrTruncated
+	}
+
+	for !extensions.empty() {
+		var typ uint16
+		var data reader
+		if !extensions.readUint16(&typ) || !extensions.readBytes16(&data) {
+			return nil, ErrTruncated
+		}
+
+		switch typ {
+		case extensionServerName:
+			hello.ServerName = parseServerName(data)
+		case extensionSupportedVersions:
+			var list reader
+			if !data.readBytes8(&list) {
+				return nil, ErrTruncated
+			}
+			hello.SupportedVersions = list.uint16s()
+		case extensionSupportedGroups:
+			var list reader
+			if !data.readBytes16(&list) {
+				return nil, ErrTruncated
+			}
+			hello.SupportedGroups = list.uint16s()
+		case extensionSignatureAlgorithms:
+			var list reader
+			if !data.readBytes16(&list) {
+				return nil, ErrTruncated
+			}
+			hello.SignatureAlgorithms = list.uint16s()
+		case extensionKeyShare:
+			var list reader
+			if !data.readBytes16(&list) {
+				return nil, ErrTruncated
+			}
+			for !list.empty() {
+				var share KeyShare
+				var key reader
+				if !list.readUint16(&share.Group) || !list.readBytes16(&key) {
+					return nil, ErrTruncated
+				}
+				share.Data = key
+				hello.KeyShares = append(hello.KeyShares, share)
+			}
+		}
+	}
+
+	return hello, nil
+}
+
+// ServerHello holds the negotiation parameters selected by a server.
+type ServerHello struct {
+	// Version is the legacy_version field. TLS 1.3 servers report the
+	// negotiated version in SupportedVersion instead.
+	Version          uint16
+	Random           []byte
+	SessionID        []byte
+	CipherSuite      uint16
+	SupportedVersion uint16
+}
+
+// ParseServerHello decodes a ServerHello handshake message, including its
+// four byte header.
+func ParseServerHello(m Message) (*ServerHello, error) {
+	body, err := messageBody(m, TypeServerHello)
+	if err != nil {
+		return nil, err
+	}
+
+	s := reader(body)
+	hello := &ServerHello{}
+
+	var random, extensions reader
+	if !s.readUint16(&hello.Version) ||
+		!s.readN(32, &random) ||
+		!s.readBytes8((*reader)(&hello.SessionID)) ||
+		!s.readUint16(&hello.CipherSuite) ||
+		!s.skip(1) {
+		return nil, ErrTruncated
+	}
+	hello.Random = random
+
+	if s.empty() {
+		return hello, nil
+	}
+	if !s.readBytes16(&extensions) {
+		return nil, ErrTruncated
+	}
+
+	for !extensions.empty() {
+		var typ uint16
+		var data reader
+		if !extensions.readUint16(&typ) || !extensions.readBytes16(&data) {
+			return nil, ErrTruncated
+		}
+		if typ == extensionSupportedVersions && !data.readUint16(&hello.SupportedVersion) {
+			return nil, ErrTruncated
+		}
+	}
+
+	return hello, nil
+}
+
+// NegotiatedVersion returns the protocol version selected by the server.
+func (h *ServerHello) NegotiatedVersion() uint16 {
+	if h.SupportedVersion != 0 {
+		return h.SupportedVersion
+	}
+	return h.Version
+}
+
+// IsHelloRetryRequest reports whether the message is a TLS 1.3
+// HelloRetryRequest rather than a genuine ServerHello.
+func (h *ServerHello) IsHelloRetryRequest() bool {
+	return bytes.Equal(h.Random, helloRetryRequestRandom)
+}
+
+func messageBody(m Message, typ uint8) ([]byte, error) {
+	if len(m) < 4 {
+		return nil, ErrTruncated
+	}
+	if m[0] != typ {
+		return nil, fmt.Errorf("handshake: unexpected message type %d, want %d", m[0], typ)
+	}
+	length := int(m[1])<<16 | int(m[2])<<8 | int(m[3])
+	if len(m) < 4+length {
+		return nil, ErrTruncated
+	}
+	return m[4 : 4+length], nil
+}
+
+func parseServerName(data reader) string {
+	var list reader
+	if !data.readBytes16(&list) {
+		return ""
+	}
+	for !list.empty() {
+		var typ uint8
+		var name reader
+		if !list.readUint8(&typ) || !list.readBytes16(&name) {
+			return ""
+		}
+		if typ == 0 {
+			return string(name)
+		}
+	}
+	return ""
+}
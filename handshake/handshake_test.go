@@ -0,0 +1,166 @@
+package handshake_test
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/handshake"
+)
+
+func TestParseClientHello(t *testing.T) {
+	t.Parallel()
+
+	stream := clientHelloStream(t, &tls.Config{
+		ServerName:       "example.test",
+		MinVersion:       tls.VersionTLS12,
+		CipherSuites:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+		CurvePreferences: []tls.CurveID{tls.X25519, tls.CurveP256},
+	})
+
+	// Split the single handshake record into two to exercise reassembly of
+	// fragmented handshake messages.
+	stream = fragment(stream, 40)
+
+	messages, err := handshake.ReadMessages(stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 1 {
+		t.Fatalf("mismatch:\n  got:  %d messages\n  want: 1 message", len(messages))
+	}
+
+	hello, err := handshake.ParseClientHello(messages[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if hello.ServerName != "example.test" {
+		t.Errorf("mismatch:\n  got:  %q\n  want: %q", hello.ServerName, "example.test")
+	}
+	if !contains(hello.CipherSuites, tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256) {
+		t.Errorf("cipher suites %#v do not contain configured suite", hello.CipherSuites)
+	}
+	if want := []uint16{tls.VersionTLS13, tls.VersionTLS12}; !reflect.DeepEqual(hello.SupportedVersions, want) {
+		t.Errorf("mismatch:\n  got:  %#v\n  want: %#v", hello.SupportedVersions, want)
+	}
+	if !contains(hello.SupportedGroups, uint16(tls.CurveP256)) {
+		t.Errorf("supported groups %#v do not contain P-256", hello.SupportedGroups)
+	}
+}
+
+func TestParseClientHelloTruncated(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		message handshake.Message
+	}{
+		"empty": {
+			message: handshake.Message{},
+		},
+		"header only": {
+			message: handshake.Message{handshake.TypeClientHello, 0, 0, 10},
+		},
+		"missing cipher suites": {
+			message: handshake.Message{handshake.TypeClientHello, 0, 0, 2, 0x03, 0x03},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := handshake.ParseClientHello(tt.message); err != handshake.ErrTruncated {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, handshake.ErrTruncated)
+			}
+		})
+	}
+}
+
+func TestClientHelloMarshal(t *testing.T) {
+	t.Parallel()
+
+	want := &handshake.ClientHello{
+		Version:             tls.VersionTLS12,
+		Random:              make([]byte, 32),
+		SessionID:           []byte{1, 2, 3},
+		CipherSuites:        []uint16{tls.TLS_AES_128_GCM_SHA256},
+		ServerName:          "example.test",
+		SupportedVersions:   []uint16{tls.VersionTLS13},
+		SupportedGroups:     []uint16{uint16(tls.X25519)},
+		SignatureAlgorithms: []uint16{uint16(tls.ECDSAWithP256AndSHA256)},
+		KeyShares:           []handshake.KeyShare{{Group: uint16(tls.X25519), Data: make([]byte, 32)}},
+	}
+
+	messages, err := handshake.ReadMessages(want.Marshal().Record())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 1 {
+		t.Fatalf("mismatch:\n  got:  %d messages\n  want: 1 message", len(messages))
+	}
+
+	got, err := handshake.ParseClientHello(messages[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %#v\n  want: %#v", got, want)
+	}
+}
+
+func TestReadMessagesNotTLS(t *testing.T) {
+	t.Parallel()
+
+	_, err := handshake.ReadMessages([]byte("GET / HTTP/1.1\r\n\r\n"))
+	if err != handshake.ErrNotTLS {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, handshake.ErrNotTLS)
+	}
+}
+
+// clientHelloStream captures the first record written by a crypto/tls client.
+func clientHelloStream(t *testing.T, config *tls.Config) []byte {
+	t.Helper()
+
+	client, server := net.Pipe()
+	defer server.Close()
+
+	go func() {
+		defer client.Close()
+		_ = tls.Client(client, config).Handshake()
+	}()
+
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(server, header); err != nil {
+		t.Fatal(err)
+	}
+	body := make([]byte, int(header[3])<<8|int(header[4]))
+	if _, err := io.ReadFull(server, body); err != nil {
+		t.Fatal(err)
+	}
+
+	return append(header, body...)
+}
+
+// fragment splits a single TLS record into two records at offset n of its
+// payload.
+func fragment(record []byte, n int) []byte {
+	header, body := record[:5], record[5:]
+
+	first := append([]byte{header[0], header[1], header[2], byte(n >> 8), byte(n)}, body[:n]...)
+	rest := len(body) - n
+	second := append([]byte{header[0], header[1], header[2], byte(rest >> 8), byte(rest)}, body[n:]...)
+
+	return append(first, second...)
+}
+
+func contains(values []uint16, v uint16) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
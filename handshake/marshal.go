@@ -0,0 +1,139 @@
+package handshake
+
+// Marshal encodes the ClientHello as a handshake message. Extensions are
+// only included for the fields that are set, except for the point formats
+// and renegotiation extensions that many servers expect of every client.
+func (h *ClientHello) Marshal() Message {
+	var body builder
+	body.addUint16(h.Version)
+	random := h.Random
+	if len(random) != 32 {
+		random = make([]byte, 32)
+	}
+	body.add(random)
+	body.addBytes8(h.SessionID)
+	body.addVector16(func(b *builder) {
+		for _, suite := range h.CipherSuites {
+			b.addUint16(suite)
+		}
+	})
+	// Only the null compression method is offered.
+	body.addBytes8([]byte{0})
+
+	var extensions builder
+	if h.ServerName != "" {
+		extensions.addExtension(extensionServerName, func(b *builder) {
+			b.addVector16(func(b *builder) {
+				b.addUint8(0)
+				b.addBytes16([]byte(h.ServerName))
+			})
+		})
+	}
+	if len(h.SupportedGroups) > 0 {
+		extensions.addExtension(extensionSupportedGroups, func(b *builder) {
+			b.addUint16List16(h.SupportedGroups)
+		})
+	}
+	extensions.addExtension(extensionECPointFormats, func(b *builder) {
+		b.addBytes8([]byte{0})
+	})
+	if len(h.SignatureAlgorithms) > 0 {
+		extensions.addExtension(extensionSignatureAlgorithms, func(b *builder) {
+			b.addUint16List16(h.SignatureAlgorithms)
+		})
+	}
+	if len(h.SupportedVersions) > 0 {
+		extensions.addExtension(extensionSupportedVersions, func(b *builder) {
+			b.addVector8(func(b *builder) {
+				for _, v := range h.SupportedVersions {
+					b.addUint16(v)
+				}
+			})
+		})
+	}
+	if len(h.KeyShares) > 0 {
+		extensions.addExtension(extensionKeyShare, func(b *builder) {
+			b.addVector16(func(b *builder) {
+				for _, share := range h.KeyShares {
+					b.addUint16(share.Group)
+					b.addBytes16(share.Data)
+				}
+			})
+		})
+	}
+	extensions.addExtension(extensionRenegotiationInfo, func(b *builder) {
+		b.addBytes8(nil)
+	})
+	body.addBytes16(extensions)
+
+	var m builder
+	m.addUint8(TypeClientHello)
+	m.addUint24(len(body))
+	m.add(body)
+	return Message(m)
+}
+
+// Record wraps the message in a single plaintext handshake record.
+func (m Message) Record() []byte {
+	var b builder
+	b.addUint8(recordHandshake)
+	// Record layer version 1.0 maximises compatibility with older servers.
+	b.addUint16(0x0301)
+	b.addBytes16(m)
+	return b
+}
+
+// builder appends big-endian fields in the style of the TLS presentation
+// language. It is the counterpart to reader.
+type builder []byte
+
+func (b *builder) add(data []byte) {
+	*b = append(*b, data...)
+}
+
+func (b *builder) addUint8(v uint8) {
+	*b = append(*b, v)
+}
+
+func (b *builder) addUint16(v uint16) {
+	*b = append(*b, byte(v>>8), byte(v))
+}
+
+func (b *builder) addUint24(v int) {
+	*b = append(*b, byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (b *builder) addBytes8(data []byte) {
+	b.addUint8(uint8(len(data)))
+	b.add(data)
+}
+
+func (b *builder) addBytes16(data []byte) {
+	b.addUint16(uint16(len(data)))
+	b.add(data)
+}
+
+func (b *builder) addVector8(f func(*builder)) {
+	var child builder
+	f(&child)
+	b.addBytes8(child)
+}
+
+func (b *builder) addVector16(f func(*builder)) {
+	var child builder
+	f(&child)
+	b.addBytes16(child)
+}
+
+func (b *builder) addUint16List16(values []uint16) {
+	b.addVector16(func(b *builder) {
+		for _, v := range values {
+			b.addUint16(v)
+		}
+	})
+}
+
+func (b *builder) addExtension(typ uint16, f func(*builder)) {
+	b.addUint16(typ)
+	b.addVector16(f)
+}
@@ -0,0 +1,71 @@
+package handshake
+
+// reader consumes big-endian, length-prefixed fields from a byte slice in the
+// style of the TLS presentation language.
+type reader []byte
+
+func (r *reader) empty() bool {
+	return len(*r) == 0
+}
+
+func (r *reader) skip(n int) bool {
+	if len(*r) < n {
+		return false
+	}
+	*r = (*r)[n:]
+	return true
+}
+
+func (r *reader) readN(n int, out *reader) bool {
+	if len(*r) < n {
+		return false
+	}
+	*out = (*r)[:n]
+	*r = (*r)[n:]
+	return true
+}
+
+func (r *reader) readUint8(out *uint8) bool {
+	if len(*r) < 1 {
+		return false
+	}
+	*out = (*r)[0]
+	*r = (*r)[1:]
+	return true
+}
+
+func (r *reader) readUint16(out *uint16) bool {
+	if len(*r) < 2 {
+		return false
+	}
+	*out = uint16((*r)[0])<<8 | uint16((*r)[1])
+	*r = (*r)[2:]
+	return true
+}
+
+func (r *reader) readBytes8(out *reader) bool {
+	var n uint8
+	if !r.readUint8(&n) {
+		return false
+	}
+	return r.readN(int(n), out)
+}
+
+func (r *reader) readBytes16(out *reader) bool {
+	var n uint16
+	if !r.readUint16(&n) {
+		return false
+	}
+	return r.readN(int(n), out)
+}
+
+// uint16s decodes the remaining bytes as a list of 16-bit values, ignoring any
+// odd trailing byte.
+func (r reader) uint16s() []uint16 {
+	values := make([]uint16, 0, len(r)/2)
+	for len(r) >= 2 {
+		values = append(values, uint16(r[0])<<8|uint16(r[1]))
+		r = r[2:]
+	}
+	return values
+}
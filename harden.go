@@ -0,0 +1,243 @@
+package ciphersuites
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// defaultCurvePreferences are the key exchange groups configured by [Harden]
+// when a configuration does not specify its own.
+var defaultCurvePreferences = []tls.CurveID{
+	tls.X25519MLKEM768,
+	tls.X25519,
+	tls.CurveP256,
+	tls.CurveP384,
+}
+
+// Change describes a modification [Harden] makes, or would make, to a field
+// of a [tls.Config].
+type Change struct {
+	Field string
+	From  string
+	To    string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, c.From, c.To)
+}
+
+// Conflict describes an explicitly configured [tls.Config] field that is
+// incompatible with the requested minimum classification.
+type Conflict struct {
+	Field  string
+	Reason string
+}
+
+// ConflictError is returned by [Harden] and [PlanHarden] when a configuration
+// cannot be hardened without overriding explicitly configured values.
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (e *ConflictError) Error() string {
+	reasons := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		reasons = append(reasons, c.Field+": "+c.Reason)
+	}
+	return "conflicting TLS configuration: " + strings.Join(reasons, "; ")
+}
+
+// Harden configures cfg to only negotiate cipher suites classified at least as
+// strong as min.
+//
+// Fields left at their zero value are populated: CipherSuites with every
+// suite implemented by crypto/tls that meets min and provides at least 128
+// bits of security, so 3DES and RC4 are never added, MinVersion with the
+// lowest protocol version those suites support but no lower than TLS 1.2,
+// and CurvePreferences with modern key exchange groups. Fields that have been
+// set explicitly, such as a MinVersion below TLS 1.2, are kept as they are,
+// and a [*ConflictError] is returned if any of them contradict min. When an
+// error is returned cfg is left unmodified.
+//
+// TLS 1.3 cipher suites are not configurable in crypto/tls, and are all
+// classified as [Recommended], so they are always permitted.
+func Harden(cfg *tls.Config, min Classification) error {
+	hardened, _, err := harden(cfg, min)
+	if err != nil {
+		return err
+	}
+	cfg.CipherSuites = hardened.CipherSuites
+	cfg.MinVersion = hardened.MinVersion
+	cfg.CurvePreferences = hardened.CurvePreferences
+	return nil
+}
+
+// PlanHarden reports the changes [Harden] would make to cfg without modifying
+// it, allowing an existing configuration to be verified.
+func PlanHarden(cfg *tls.Config, min Classification) ([]Change, error) {
+	_, changes, err := harden(cfg, min)
+	return changes, err
+}
+
+func harden(cfg *tls.Config, min Classification) (*tls.Config, []Change, error) {
+	var conflicts []Conflict
+	hardened := &tls.Config{
+		CipherSuites:     cfg.CipherSuites,
+		MinVersion:       cfg.MinVersion,
+		MaxVersion:       cfg.MaxVersion,
+		CurvePreferences: cfg.CurvePreferences,
+	}
+
+	if len(cfg.CipherSuites) == 0 {
+		hardened.CipherSuites = configurableSuites(min)
+	} else {
+		var rejected []string
+		for _, id := range cfg.CipherSuites {
+			cs, ok := GetCipherSuiteByID(id)
+			if !ok {
+				cs.Name = fmt.Sprintf("0x%04X", id)
+			}
+			if !cs.Classification.AtLeast(min) {
+				rejected = append(rejected, fmt.Sprintf("%s is %s", cs.Name, cs.Classification))
+			}
+		}
+		if len(rejected) > 0 {
+			conflicts = append(conflicts, Conflict{
+				Field:  "CipherSuites",
+				Reason: fmt.Sprintf("%s, below the minimum of %s", strings.Join(rejected, ", "), min),
+			})
+		}
+	}
+
+	lowest := lowestVersion(hardened.CipherSuites)
+	if cfg.MinVersion == 0 {
+		hardened.MinVersion = lowest
+		if hardened.MinVersion < tls.VersionTLS12 {
+			hardened.MinVersion = tls.VersionTLS12
+		}
+	} else if cfg.MinVersion < lowest {
+		conflicts = append(conflicts, Conflict{
+			Field: "MinVersion",
+			Reason: fmt.Sprintf("%s is allowed but no permitted cipher suite supports versions before %s",
+				VersionName(cfg.MinVersion), VersionName(lowest)),
+		})
+	}
+
+	if cfg.MaxVersion != 0 && cfg.MaxVersion < hardened.MinVersion {
+		conflicts = append(conflicts, Conflict{
+			Field: "MaxVersion",
+			Reason: fmt.Sprintf("%s is below the minimum version %s",
+				VersionName(cfg.MaxVersion), VersionName(hardened.MinVersion)),
+		})
+	}
+
+	if len(cfg.CurvePreferences) == 0 {
+		hardened.CurvePreferences = append([]tls.CurveID(nil), defaultCurvePreferences...)
+	}
+
+	if len(conflicts) > 0 {
+		return nil, nil, &ConflictError{Conflicts: conflicts}
+	}
+
+	var changes []Change
+	if len(cfg.CipherSuites) == 0 {
+		changes = append(changes, Change{"CipherSuites", "(default)", suiteNames(hardened.CipherSuites)})
+	}
+	if cfg.MinVersion != hardened.MinVersion {
+		changes = append(changes, Change{"MinVersion", "(default)", VersionName(hardened.MinVersion)})
+	}
+	if len(cfg.CurvePreferences) == 0 {
+		changes = append(changes, Change{"CurvePreferences", "(default)", curveNames(hardened.CurvePreferences)})
+	}
+
+	return hardened, changes, nil
+}
+
+// configurableSuites returns the TLS 1.0-1.2 cipher suites implemented by
+// crypto/tls that meet min and provide at least 128 bits of security,
+// strongest first.
+func configurableSuites(min Classification) []uint16 {
+	type candidate struct {
+		id             uint16
+		classification Classification
+	}
+
+	var candidates []candidate
+	all := append(tls.CipherSuites(), tls.InsecureCipherSuites()...)
+	for _, suite := range all {
+		if !supportsPreTLS13(suite.SupportedVersions) {
+			continue
+		}
+		cs, ok := GetCipherSuiteByID(suite.ID)
+		if ok && cs.SecurityBits >= 128 && cs.Classification.AtLeast(min) {
+			candidates = append(candidates, candidate{suite.ID, cs.Classification})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].classification.rank() > candidates[j].classification.rank()
+	})
+
+	ids := make([]uint16, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.id)
+	}
+	return ids
+}
+
+// lowestVersion returns the lowest protocol version supported by any of the
+// given cipher suites, or TLS 1.3 if none of them are usable before it.
+func lowestVersion(ids []uint16) uint16 {
+	lowest := uint16(tls.VersionTLS13)
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		if !containsID(ids, suite.ID) {
+			continue
+		}
+		for _, v := range suite.SupportedVersions {
+			if v < lowest {
+				lowest = v
+			}
+		}
+	}
+	return lowest
+}
+
+func supportsPreTLS13(versions []uint16) bool {
+	for _, v := range versions {
+		if v < tls.VersionTLS13 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(ids []uint16, id uint16) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func suiteNames(ids []uint16) string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := CipherSuiteName(id)
+		if !ok {
+			name = fmt.Sprintf("0x%04X", id)
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func curveNames(curves []tls.CurveID) string {
+	names := make([]string, 0, len(curves))
+	for _, c := range curves {
+		names = append(names, c.String())
+	}
+	return strings.Join(names, ", ")
+}
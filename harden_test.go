@@ -0,0 +1,171 @@
+package ciphersuites_test
+
+import (
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestHarden(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		min            ciphersuites.Classification
+		wantMinVersion uint16
+	}{
+		"hardens to recommended": {
+			min:            ciphersuites.Recommended,
+			wantMinVersion: tls.VersionTLS12,
+		},
+		"hardens to secure": {
+			min:            ciphersuites.Secure,
+			wantMinVersion: tls.VersionTLS12,
+		},
+		"hardens to weak": {
+			min:            ciphersuites.Weak,
+			wantMinVersion: tls.VersionTLS12,
+		},
+		"hardens to insecure": {
+			min:            ciphersuites.Insecure,
+			wantMinVersion: tls.VersionTLS12,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &tls.Config{}
+			if err := ciphersuites.Harden(cfg, tt.min); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if cfg.MinVersion != tt.wantMinVersion {
+				t.Errorf("mismatch:\n  got:  %s\n  want: %s", ciphersuites.VersionName(cfg.MinVersion), ciphersuites.VersionName(tt.wantMinVersion))
+			}
+			if len(cfg.CipherSuites) == 0 {
+				t.Fatal("no cipher suites configured")
+			}
+			for _, id := range cfg.CipherSuites {
+				cs, ok := ciphersuites.GetCipherSuiteByID(id)
+				if !ok || !cs.Classification.AtLeast(tt.min) {
+					t.Errorf("%s is %s, below %s", cs.Name, cs.Classification, tt.min)
+				}
+				if cs.SecurityBits < 128 {
+					t.Errorf("%s provides %d bits of security, below 128", cs.Name, cs.SecurityBits)
+				}
+			}
+			if len(cfg.CurvePreferences) == 0 {
+				t.Error("no curve preferences configured")
+			}
+		})
+	}
+}
+
+func TestHardenKeepsExplicitMinVersion(t *testing.T) {
+	t.Parallel()
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS10}
+	if err := ciphersuites.Harden(cfg, ciphersuites.Weak); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MinVersion != tls.VersionTLS10 {
+		t.Errorf("mismatch:\n  got:  %s\n  want: %s", ciphersuites.VersionName(cfg.MinVersion), ciphersuites.VersionName(tls.VersionTLS10))
+	}
+}
+
+func TestHardenConflicts(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cfg        *tls.Config
+		wantFields []string
+	}{
+		"rejects weak cipher suite": {
+			cfg: &tls.Config{
+				CipherSuites: []uint16{
+					tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+					tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+				},
+			},
+			wantFields: []string{"CipherSuites"},
+		},
+		"rejects minimum version without suites": {
+			cfg: &tls.Config{
+				MinVersion: tls.VersionTLS10,
+			},
+			wantFields: []string{"MinVersion"},
+		},
+		"rejects maximum version below minimum": {
+			cfg: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				MaxVersion: tls.VersionTLS11,
+			},
+			wantFields: []string{"MaxVersion"},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			before := len(tt.cfg.CipherSuites)
+			err := ciphersuites.Harden(tt.cfg, ciphersuites.Secure)
+
+			var conflictErr *ciphersuites.ConflictError
+			if !errors.As(err, &conflictErr) {
+				t.Fatalf("mismatch:\n  got:  %v\n  want: *ciphersuites.ConflictError", err)
+			}
+
+			var fields []string
+			for _, c := range conflictErr.Conflicts {
+				fields = append(fields, c.Field)
+			}
+			if !stringsEqual(fields, tt.wantFields) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", fields, tt.wantFields)
+			}
+			if len(tt.cfg.CipherSuites) != before {
+				t.Error("configuration was modified despite conflicts")
+			}
+		})
+	}
+}
+
+func TestPlanHarden(t *testing.T) {
+	t.Parallel()
+
+	cfg := &tls.Config{
+		CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	changes, err := ciphersuites.PlanHarden(cfg, ciphersuites.Recommended)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var fields []string
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+	if want := []string{"CurvePreferences"}; !stringsEqual(fields, want) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", fields, want)
+	}
+	if cfg.CurvePreferences != nil {
+		t.Error("configuration was modified by plan")
+	}
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
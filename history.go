@@ -0,0 +1,64 @@
+package ciphersuites
+
+import (
+	"sort"
+	"time"
+)
+
+// ClassificationChange records the classification of a cipher suite from the
+// date of a registry snapshot until the next change.
+type ClassificationChange struct {
+	Year  int
+	Month time.Month
+	Day   int
+
+	// IANARecommended reports whether the registry marked the cipher suite as
+	// recommended.
+	IANARecommended bool
+	Classification  Classification
+
+	// Removed reports whether the cipher suite no longer appeared in the
+	// registry, in which case its classification is Unknown.
+	Removed bool
+}
+
+// Date returns the date of the snapshot in which the change was first seen.
+func (c ClassificationChange) Date() time.Time {
+	return time.Date(c.Year, c.Month, c.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// ClassificationHistory returns every change to the classification of the
+// named cipher suite, oldest first. The first entry records the earliest
+// snapshot that contains the cipher suite, and an entry is Removed if the
+// cipher suite dropped out of a later snapshot.
+func ClassificationHistory(name string) []ClassificationChange {
+	return append([]ClassificationChange(nil), classificationHistory[name]...)
+}
+
+// ClassificationAt returns the classification the named cipher suite had on
+// the given date, according to the registry snapshots the package was
+// generated from. It returns false if the cipher suite does not appear in any
+// snapshot taken on or before that date, or had dropped out of the registry by
+// then.
+func ClassificationAt(name string, date time.Time) (Classification, bool) {
+	changes := classificationHistory[name]
+	i := sort.Search(len(changes), func(i int) bool {
+		return changes[i].Date().After(date)
+	})
+	if i == 0 || changes[i-1].Removed {
+		return Unknown, false
+	}
+	return changes[i-1].Classification, true
+}
+
+// HistorySnapshots returns the dates of the registry snapshots the history
+// was generated from, oldest first.
+func HistorySnapshots() []time.Time {
+	dates := make([]time.Time, 0, len(historySnapshots))
+	for _, s := range historySnapshots {
+		if d, err := time.Parse("2006-01-02", s); err == nil {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
@@ -0,0 +1,118 @@
+package ciphersuites_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestClassificationAt(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		name   string
+		date   time.Time
+		want   ciphersuites.Classification
+		wantOK bool
+	}{
+		"returns classification after first snapshot": {
+			name:   "TLS_AES_128_GCM_SHA256",
+			date:   time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Recommended,
+			wantOK: true,
+		},
+		"returns classification on snapshot date": {
+			name:   "TLS_RSA_WITH_RC4_128_SHA",
+			date:   time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Insecure,
+			wantOK: true,
+		},
+		"returns classification before a later change": {
+			name:   "TLS_DHE_RSA_WITH_AES_128_GCM_SHA256",
+			date:   time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Secure,
+			wantOK: true,
+		},
+		"returns classification after a later change": {
+			name:   "TLS_DHE_RSA_WITH_AES_128_GCM_SHA256",
+			date:   time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Insecure,
+			wantOK: true,
+		},
+		"returns false before cipher suite was assigned": {
+			name:   "TLS_AEGIS_128L_SHA256",
+			date:   time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Unknown,
+			wantOK: false,
+		},
+		"returns false before first snapshot": {
+			name:   "TLS_AES_128_GCM_SHA256",
+			date:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Unknown,
+			wantOK: false,
+		},
+		"returns false for unknown cipher suite": {
+			name:   "TLS_UNKNOWN",
+			date:   time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+			want:   ciphersuites.Unknown,
+			wantOK: false,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ciphersuites.ClassificationAt(tt.name, tt.date)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("mismatch:\n  got:  %v, %v\n  want: %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestClassificationHistory(t *testing.T) {
+	t.Parallel()
+
+	got := ciphersuites.ClassificationHistory("TLS_RSA_WITH_AES_128_GCM_SHA256")
+	want := []ciphersuites.ClassificationChange{
+		{Year: 2023, Month: time.June, Day: 1, Classification: ciphersuites.Secure},
+		{Year: 2026, Month: time.January, Day: 30, Classification: ciphersuites.Insecure},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, want)
+	}
+}
+
+func TestClassificationHistoryMatchesDataset(t *testing.T) {
+	t.Parallel()
+
+	snapshots := ciphersuites.HistorySnapshots()
+	if len(snapshots) == 0 {
+		t.Fatal("expected at least one snapshot")
+	}
+	latest := snapshots[len(snapshots)-1]
+
+	for _, suites := range []map[string]ciphersuites.CipherSuite{
+		ciphersuites.RecommendedCipherSuites,
+		ciphersuites.SecureCipherSuites,
+		ciphersuites.WeakCipherSuites,
+		ciphersuites.InsecureCipherSuites,
+	} {
+		for name, cs := range suites {
+			history := ciphersuites.ClassificationHistory(name)
+			if len(history) == 0 {
+				t.Errorf("%s: missing history", name)
+				continue
+			}
+			if got, _ := ciphersuites.ClassificationAt(name, latest); got != cs.Classification {
+				t.Errorf("%s: mismatch:\n  got:  %v\n  want: %v", name, got, cs.Classification)
+			}
+			if last := history[len(history)-1]; last.IANARecommended != cs.IsRecommended() {
+				t.Errorf("%s: mismatch:\n  got:  %v\n  want: %v", name, last.IANARecommended, cs.IsRecommended())
+			}
+		}
+	}
+}
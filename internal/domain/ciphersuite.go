@@ -1,13 +1,58 @@
 package domain
 
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
 // CipherSuite represents a TLS cipher suite.
 type CipherSuite struct {
-	Name        string
+	Name string
+
+	// Value is the identifier as written in the registry, such as 0x13,0x01.
+	Value string
+
 	Protocol    string
 	Encryption  string
 	Hash        string
 	Security    SecurityLevel
 	TLSVersions []string
+
+	// SecurityBits is the effective security strength in bits.
+	SecurityBits int
+
+	// IANARecommended is the value of the registry's Recommended column: Y,
+	// N or D.
+	IANARecommended string
+
+	// Reference and Comment are copied from the registry, along with any
+	// other columns the parser does not interpret, which are kept in Extra
+	// by column name.
+	Reference string
+	Comment   string
+	Extra     map[string]string
+
+	// Deprecation is nil if the cipher suite has not been deprecated.
+	Deprecation *Deprecation
+}
+
+// ID returns the identifier as a number, such as 0x1301, or zero if Value is
+// not a pair of bytes.
+func (c CipherSuite) ID() uint16 {
+	parts := strings.Split(c.Value, ",")
+	if len(parts) != 2 {
+		return 0
+	}
+	var id uint16
+	for _, part := range parts {
+		b, err := strconv.ParseUint(strings.TrimSpace(part), 0, 8)
+		if err != nil {
+			return 0
+		}
+		id = id<<8 | uint16(b)
+	}
+	return id
 }
 
 // SecurityLevel represents the security classification of a cipher suite
@@ -18,4 +63,36 @@ const (
 	Secure      SecurityLevel = "Secure"
 	Weak        SecurityLevel = "Weak"
 	Insecure    SecurityLevel = "Insecure"
+
+	// Unknown is the level recorded for a cipher suite that has dropped out
+	// of the registry.
+	Unknown SecurityLevel = "Unknown"
 )
+
+// Snapshot is the content of the registry on a given date.
+type Snapshot struct {
+	Date   time.Time
+	SHA256 string
+	Suites []CipherSuite
+}
+
+// Deprecation records when a cipher suite was deprecated and when support
+// for it was removed from major implementations.
+type Deprecation struct {
+	Since   time.Time
+	Removal time.Time
+	Sources []string
+}
+
+// Attack describes a known attack on the cipher suites whose names contain
+// any of its patterns. If Versions is not empty, the attack only applies to
+// cipher suites that can be negotiated with one of those protocol versions.
+type Attack struct {
+	ID          string
+	Name        string
+	CVEs        []string
+	Description string
+	References  []string
+	Patterns    []string
+	Versions    []string
+}
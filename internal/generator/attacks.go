@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/domain"
+)
+
+// AttackGenerator produces Go source code for the catalogue of known attacks
+// on cipher suites.
+type AttackGenerator struct {
+	packageName string
+	template    *template.Template
+}
+
+// NewAttackGenerator creates a new attack catalogue generator.
+func NewAttackGenerator(packageName string) *AttackGenerator {
+	tmpl := template.Must(template.New("attacks").Parse(attackTemplate))
+
+	return &AttackGenerator{
+		packageName: packageName,
+		template:    tmpl,
+	}
+}
+
+// Generate produces Go source code from the attack catalogue.
+func (g *AttackGenerator) Generate(attacks []domain.Attack) ([]byte, error) {
+	data := AttackTemplateData{
+		PackageName: g.packageName,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Attacks:     attacks,
+	}
+
+	var buf bytes.Buffer
+	if err := g.template.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("template execution failed: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// AttackTemplateData contains the data for attack catalogue generation.
+type AttackTemplateData struct {
+	PackageName string
+	Timestamp   string
+	Attacks     []domain.Attack
+}
+
+const attackTemplate = `// Code generated by cipher suite generator. DO NOT EDIT.
+// Generated at: {{.Timestamp}}
+
+package {{.PackageName}}
+
+// attackCatalogue lists the published attacks on cipher suites.
+var attackCatalogue = []Attack{
+{{- range .Attacks}}
+	{
+		ID:          {{printf "%q" .ID}},
+		Name:        {{printf "%q" .Name}},
+		CVEs:        []string{ {{range $i, $v := .CVEs}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end}} },
+		Description: {{printf "%q" .Description}},
+		References: []string{
+{{- range .References}}
+			{{printf "%q" .}},
+{{- end}}
+		},
+		patterns: []string{ {{range $i, $v := .Patterns}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end}} },
+{{- if .Versions}}
+		versions: []string{ {{range $i, $v := .Versions}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end}} },
+{{- end}}
+	},
+{{- end}}
+}
+`
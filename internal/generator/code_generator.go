@@ -14,16 +14,22 @@ import (
 type CodeGenerator struct {
 	packageName string
 	sourceURL   string
+	checksum    string
 	template    *template.Template
 }
 
-// NewCodeGenerator creates a new code generator.
-func NewCodeGenerator(packageName, sourceURL string) *CodeGenerator {
-	tmpl := template.Must(template.New("ciphersuites").Parse(codeTemplate))
+// NewCodeGenerator creates a new code generator. The checksum is the
+// hex-encoded SHA-256 digest of the registry file fetched from sourceURL, or
+// empty if it is not known.
+func NewCodeGenerator(packageName, sourceURL, checksum string) *CodeGenerator {
+	tmpl := template.Must(template.New("ciphersuites").Funcs(template.FuncMap{
+		"date": dateLiteral,
+	}).Parse(codeTemplate))
 
 	return &CodeGenerator{
 		packageName: packageName,
 		sourceURL:   sourceURL,
+		checksum:    checksum,
 		template:    tmpl,
 	}
 }
@@ -37,7 +43,10 @@ func (g *CodeGenerator) Generate(grouped map[domain.SecurityLevel][]domain.Ciphe
 		domain.Insecure,
 	}
 
-	var securityLevels []SecurityLevelGroup
+	var (
+		securityLevels []SecurityLevelGroup
+		importTime     bool
+	)
 	for _, level := range orderedLevels {
 		if suites, exists := grouped[level]; exists && len(suites) > 0 {
 			description := strings.ToLower(string(level)) + " cipher suites"
@@ -45,6 +54,10 @@ func (g *CodeGenerator) Generate(grouped map[domain.SecurityLevel][]domain.Ciphe
 				description = "secure cipher suites recommended for\n// use"
 			}
 
+			for _, suite := range suites {
+				importTime = importTime || suite.Deprecation != nil
+			}
+
 			securityLevels = append(securityLevels, SecurityLevelGroup{
 				Name:        string(level),
 				Description: description,
@@ -57,6 +70,8 @@ func (g *CodeGenerator) Generate(grouped map[domain.SecurityLevel][]domain.Ciphe
 		PackageName:    g.packageName,
 		Timestamp:      time.Now().UTC().Format(time.RFC3339),
 		SourceURL:      g.sourceURL,
+		Checksum:       g.checksum,
+		ImportTime:     importTime,
 		SecurityLevels: securityLevels,
 	}
 
@@ -73,6 +88,8 @@ type TemplateData struct {
 	PackageName    string
 	Timestamp      string
 	SourceURL      string
+	Checksum       string
+	ImportTime     bool
 	SecurityLevels []SecurityLevelGroup
 }
 
@@ -84,16 +101,55 @@ type SecurityLevelGroup struct {
 	Suites      []domain.CipherSuite
 }
 
+// dateLiteral formats t as a Go expression.
+func dateLiteral(t time.Time) string {
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, 0, 0, 0, 0, time.UTC)", t.Year(), t.Month(), t.Day())
+}
+
 const codeTemplate = `// Code generated by cipher suite generator. DO NOT EDIT.
 // Generated at: {{.Timestamp}}
 // Source: {{.SourceURL}}
+{{- if .Checksum}}
+// SHA-256: {{.Checksum}}
+{{- end}}
 
 package {{.PackageName}}
-
+{{if .ImportTime}}
+import "time"
+{{end}}
+// datasetSource is the URL the registry was fetched from, or the path of the
+// local copy it was read from.
+const datasetSource = {{printf "%q" .SourceURL}}
+
+// datasetChecksum is the hex-encoded SHA-256 digest of the registry file, or
+// empty if it was not recorded.
+const datasetChecksum = {{printf "%q" .Checksum}}
 {{range .SecurityLevels}}
 // {{.Name}}CipherSuites is a list of {{.Description}}.
 var {{.Name}}CipherSuites = map[string]CipherSuite{
-{{range .Suites}}	"{{.Name}}": {"{{.Protocol}}", "{{.Encryption}}", "{{.Hash}}", {{.Security}}, []string{ {{range $i, $v := .TLSVersions}}{{if $i}}, {{end}}"{{$v}}"{{end}} }},
+{{range .Suites}}	"{{.Name}}": {
+		ID:                  {{printf "0x%04X" .ID}},
+		Name:                "{{.Name}}",
+		ProtocolVersion:     "{{.Protocol}}",
+		EncryptionAlgorithm: "{{.Encryption}}",
+		HashAlgorithm:       "{{.Hash}}",
+		Classification:      {{.Security}},
+		SecurityBits:        {{.SecurityBits}},
+		TLSVersions:         []string{ {{range $i, $v := .TLSVersions}}{{if $i}}, {{end}}"{{$v}}"{{end}} },
+{{- with .Deprecation}}
+		Deprecation: &Deprecation{
+			Since: {{date .Since}},
+{{- if not .Removal.IsZero}}
+			Removal: {{date .Removal}},
+{{- end}}
+			Sources: []string{
+{{- range .Sources}}
+				{{printf "%q" .}},
+{{- end}}
+			},
+		},
+{{- end}}
+	},
 {{end}}}
 
 {{end}}`
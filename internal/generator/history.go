@@ -0,0 +1,165 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/domain"
+)
+
+// HistoryEntry records the state of a cipher suite from the date of a
+// snapshot until the next entry. Removed entries record that the cipher suite
+// no longer appeared in the registry.
+type HistoryEntry struct {
+	Year, Month, Day int
+	Recommended      bool
+	Security         domain.SecurityLevel
+	Removed          bool
+}
+
+// SuiteHistory lists the changes to a single cipher suite, oldest first.
+type SuiteHistory struct {
+	Name    string
+	Entries []HistoryEntry
+}
+
+// BuildHistory compacts a series of snapshots, ordered oldest first, into the
+// dates on which each cipher suite first appeared, its IANA Recommended flag
+// or security level changed, or it dropped out of the registry.
+func BuildHistory(snapshots []domain.Snapshot) []SuiteHistory {
+	index := make(map[string]int)
+	var history []SuiteHistory
+
+	for _, snapshot := range snapshots {
+		year, month, day := snapshot.Date.Date()
+		present := make(map[string]bool, len(snapshot.Suites))
+		for _, suite := range snapshot.Suites {
+			present[suite.Name] = true
+
+			entry := HistoryEntry{
+				Year:        year,
+				Month:       int(month),
+				Day:         day,
+				Recommended: suite.IANARecommended == "Y",
+				Security:    suite.Security,
+			}
+
+			i, ok := index[suite.Name]
+			if !ok {
+				index[suite.Name] = len(history)
+				history = append(history, SuiteHistory{Name: suite.Name, Entries: []HistoryEntry{entry}})
+				continue
+			}
+
+			last := history[i].Entries[len(history[i].Entries)-1]
+			if last.Removed || last.Recommended != entry.Recommended || last.Security != entry.Security {
+				history[i].Entries = append(history[i].Entries, entry)
+			}
+		}
+
+		for i := range history {
+			entries := history[i].Entries
+			if present[history[i].Name] || entries[len(entries)-1].Removed {
+				continue
+			}
+			history[i].Entries = append(entries, HistoryEntry{
+				Year:     year,
+				Month:    int(month),
+				Day:      day,
+				Security: domain.Unknown,
+				Removed:  true,
+			})
+		}
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Name < history[j].Name
+	})
+
+	return history
+}
+
+// HistoryGenerator produces Go source code recording how cipher suite
+// classifications changed across registry snapshots.
+type HistoryGenerator struct {
+	packageName string
+	template    *template.Template
+}
+
+// NewHistoryGenerator creates a new history generator.
+func NewHistoryGenerator(packageName string) *HistoryGenerator {
+	tmpl := template.Must(template.New("history").Parse(historyTemplate))
+
+	return &HistoryGenerator{
+		packageName: packageName,
+		template:    tmpl,
+	}
+}
+
+// Generate produces Go source code from snapshots ordered oldest first.
+func (g *HistoryGenerator) Generate(snapshots []domain.Snapshot) ([]byte, error) {
+	var (
+		dates   []string
+		digests []SnapshotDigest
+	)
+	for _, s := range snapshots {
+		date := s.Date.Format("2006-01-02")
+		dates = append(dates, date)
+		digests = append(digests, SnapshotDigest{Date: date, SHA256: s.SHA256})
+	}
+
+	data := HistoryTemplateData{
+		PackageName: g.packageName,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Snapshots:   dates,
+		Digests:     digests,
+		History:     BuildHistory(snapshots),
+	}
+
+	var buf bytes.Buffer
+	if err := g.template.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("template execution failed: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// HistoryTemplateData contains the data for history code generation.
+type HistoryTemplateData struct {
+	PackageName string
+	Timestamp   string
+	Snapshots   []string
+	Digests     []SnapshotDigest
+	History     []SuiteHistory
+}
+
+// SnapshotDigest records the SHA-256 digest of the snapshot taken on Date, so
+// that the generated history identifies exactly which files it was built
+// from.
+type SnapshotDigest struct {
+	Date   string
+	SHA256 string
+}
+
+const historyTemplate = `// Code generated by cipher suite generator. DO NOT EDIT.
+// Generated at: {{.Timestamp}}
+// Snapshots:{{range .Snapshots}} {{.}}{{end}}
+{{- range .Digests}}{{if .SHA256}}
+// SHA-256 {{.Date}}: {{.SHA256}}{{end}}{{end}}
+
+package {{.PackageName}}
+
+// historySnapshots lists the dates of the registry snapshots the history was
+// built from, oldest first.
+var historySnapshots = []string{ {{range $i, $v := .Snapshots}}{{if $i}}, {{end}}"{{$v}}"{{end}} }
+
+// classificationHistory records, for every cipher suite, the dates on which it
+// first appeared in a snapshot, its classification changed, or it dropped out
+// of the registry.
+var classificationHistory = map[string][]ClassificationChange{
+{{range .History}}	"{{.Name}}": { {{range $i, $e := .Entries}}{{if $i}}, {{end}}{ {{$e.Year}}, {{$e.Month}}, {{$e.Day}}, {{$e.Recommended}}, {{$e.Security}}, {{$e.Removed}} }{{end}} },
+{{end}}}
+`
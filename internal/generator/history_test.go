@@ -0,0 +1,65 @@
+package generator_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/domain"
+	"github.com/tomasbasham/ciphersuites/internal/generator"
+)
+
+func TestBuildHistory(t *testing.T) {
+	t.Parallel()
+
+	suite := func(name string, recommended bool, security domain.SecurityLevel) domain.CipherSuite {
+		flag := "N"
+		if recommended {
+			flag = "Y"
+		}
+		return domain.CipherSuite{Name: name, IANARecommended: flag, Security: security}
+	}
+	snapshot := func(year int, month time.Month, suites ...domain.CipherSuite) domain.Snapshot {
+		return domain.Snapshot{Date: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), Suites: suites}
+	}
+
+	snapshots := []domain.Snapshot{
+		snapshot(2022, time.January,
+			suite("TLS_A", true, domain.Recommended),
+			suite("TLS_B", false, domain.Secure),
+		),
+		snapshot(2023, time.January,
+			suite("TLS_A", true, domain.Recommended),
+			suite("TLS_B", false, domain.Insecure),
+			suite("TLS_C", false, domain.Weak),
+		),
+		snapshot(2024, time.January,
+			suite("TLS_A", true, domain.Recommended),
+			suite("TLS_B", false, domain.Insecure),
+		),
+		snapshot(2025, time.January,
+			suite("TLS_A", true, domain.Recommended),
+			suite("TLS_C", false, domain.Weak),
+		),
+	}
+
+	want := []generator.SuiteHistory{
+		{Name: "TLS_A", Entries: []generator.HistoryEntry{
+			{Year: 2022, Month: 1, Day: 1, Recommended: true, Security: domain.Recommended},
+		}},
+		{Name: "TLS_B", Entries: []generator.HistoryEntry{
+			{Year: 2022, Month: 1, Day: 1, Security: domain.Secure},
+			{Year: 2023, Month: 1, Day: 1, Security: domain.Insecure},
+			{Year: 2025, Month: 1, Day: 1, Security: domain.Unknown, Removed: true},
+		}},
+		{Name: "TLS_C", Entries: []generator.HistoryEntry{
+			{Year: 2023, Month: 1, Day: 1, Security: domain.Weak},
+			{Year: 2024, Month: 1, Day: 1, Security: domain.Unknown, Removed: true},
+			{Year: 2025, Month: 1, Day: 1, Security: domain.Weak},
+		}},
+	}
+
+	if got := generator.BuildHistory(snapshots); !reflect.DeepEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: %+v", got, want)
+	}
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/domain"
+)
+
+// jsonVersion is the version of the dataset format, which must match the
+// version understood by the ciphersuites package.
+const jsonVersion = 1
+
+// JSONGenerator produces a JSON dataset from cipher suite data, which can be
+// loaded at runtime in place of the generated Go code.
+type JSONGenerator struct {
+	sourceURL string
+	checksum  string
+}
+
+// NewJSONGenerator creates a new JSON generator. The checksum is the
+// hex-encoded SHA-256 digest of the registry file fetched from sourceURL, or
+// empty if it is not known.
+func NewJSONGenerator(sourceURL, checksum string) *JSONGenerator {
+	return &JSONGenerator{
+		sourceURL: sourceURL,
+		checksum:  checksum,
+	}
+}
+
+// Generate produces an indented JSON dataset from grouped cipher suites.
+func (g *JSONGenerator) Generate(grouped map[domain.SecurityLevel][]domain.CipherSuite) ([]byte, error) {
+	data := jsonDataset{
+		Version:   jsonVersion,
+		Generated: time.Now().UTC().Format(time.RFC3339),
+		Source:    g.sourceURL,
+		SHA256:    g.checksum,
+	}
+
+	for _, suites := range grouped {
+		for _, suite := range suites {
+			data.CipherSuites = append(data.CipherSuites, newJSONCipherSuite(suite))
+		}
+	}
+	sort.Slice(data.CipherSuites, func(i, j int) bool {
+		return data.CipherSuites[i].Name < data.CipherSuites[j].Name
+	})
+
+	code, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode dataset: %w", err)
+	}
+
+	return append(code, '\n'), nil
+}
+
+type jsonDataset struct {
+	Version      int               `json:"version"`
+	Generated    string            `json:"generated"`
+	Source       string            `json:"source"`
+	SHA256       string            `json:"sha256,omitempty"`
+	CipherSuites []jsonCipherSuite `json:"cipher_suites"`
+}
+
+type jsonCipherSuite struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	Protocol       string           `json:"protocol"`
+	Encryption     string           `json:"encryption"`
+	Hash           string           `json:"hash"`
+	Classification string           `json:"classification"`
+	SecurityBits   int              `json:"security_bits"`
+	TLSVersions    []string         `json:"tls_versions"`
+	Deprecation    *jsonDeprecation `json:"deprecation,omitempty"`
+}
+
+type jsonDeprecation struct {
+	Since   string   `json:"since"`
+	Removal string   `json:"removal,omitempty"`
+	Sources []string `json:"sources"`
+}
+
+func newJSONCipherSuite(suite domain.CipherSuite) jsonCipherSuite {
+	cs := jsonCipherSuite{
+		ID:             fmt.Sprintf("0x%04X", suite.ID()),
+		Name:           suite.Name,
+		Protocol:       suite.Protocol,
+		Encryption:     suite.Encryption,
+		Hash:           suite.Hash,
+		Classification: strings.ToLower(string(suite.Security)),
+		SecurityBits:   suite.SecurityBits,
+		TLSVersions:    suite.TLSVersions,
+	}
+	if d := suite.Deprecation; d != nil {
+		cs.Deprecation = &jsonDeprecation{
+			Since:   d.Since.Format("2006-01-02"),
+			Sources: d.Sources,
+		}
+		if !d.Removal.IsZero() {
+			cs.Deprecation.Removal = d.Removal.Format("2006-01-02")
+		}
+	}
+	return cs
+}
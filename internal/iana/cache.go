@@ -0,0 +1,93 @@
+package iana
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cacheEntry is a registry file stored in the cache, along with the metadata
+// needed to revalidate it.
+type cacheEntry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+
+	body []byte
+}
+
+// registryCache stores the registry fetched for a single URL. A zero value
+// caches nothing.
+type registryCache struct {
+	path string
+}
+
+func (f *Fetcher) cache(url string) registryCache {
+	if f.CacheDir == "" {
+		return registryCache{}
+	}
+	sum := sha256.Sum256([]byte(url))
+	return registryCache{path: filepath.Join(f.CacheDir, hex.EncodeToString(sum[:8]))}
+}
+
+func (c registryCache) load() (*cacheEntry, error) {
+	if c.path == "" {
+		return nil, nil
+	}
+
+	meta, err := os.ReadFile(c.path + ".json")
+	if err != nil {
+		return nil, err
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(meta, &entry); err != nil {
+		return nil, err
+	}
+
+	entry.body, err = os.ReadFile(c.path + ".csv")
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (c registryCache) store(entry *cacheEntry) error {
+	if c.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	meta, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(c.path+".csv", entry.body); err != nil {
+		return err
+	}
+	return writeFileAtomic(c.path+".json", meta)
+}
+
+// writeFileAtomic replaces the named file so that readers see either the old
+// content or the new, never a partial write.
+func writeFileAtomic(name string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
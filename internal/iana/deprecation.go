@@ -0,0 +1,148 @@
+package iana
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/domain"
+)
+
+// deprecationRule deprecates every cipher suite whose name contains one of
+// its patterns or ends with one of its suffixes.
+type deprecationRule struct {
+	patterns []string
+	suffixes []string
+	since    time.Time
+	removal  time.Time
+	source   string
+}
+
+// DeprecationSchedule determines when cipher suites were deprecated, drawing
+// on RFCs, on removal announcements from major implementations and on the
+// transition schedules of standards bodies, which may lie in the future.
+type DeprecationSchedule struct {
+	rules []deprecationRule
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// NewDeprecationSchedule creates the deprecation schedule.
+func NewDeprecationSchedule() *DeprecationSchedule {
+	return &DeprecationSchedule{
+		rules: []deprecationRule{
+			{
+				patterns: []string{"_DES_CBC_", "_IDEA_"},
+				since:    date(2009, time.February, 1),
+				source:   "RFC 5469: DES and IDEA cipher suites are removed from TLS 1.2",
+			},
+			{
+				patterns: []string{"_RC4_"},
+				since:    date(2015, time.February, 1),
+				source:   "RFC 7465: RC4 cipher suites are prohibited",
+			},
+			{
+				patterns: []string{"_RC4_"},
+				removal:  date(2016, time.January, 20),
+				source:   "Chrome 48 removes RC4 cipher suites",
+			},
+			{
+				patterns: []string{"_NULL_", "_anon_", "_EXPORT"},
+				since:    date(2015, time.May, 1),
+				source:   "RFC 7525: NULL, anonymous and export cipher suites must not be negotiated",
+			},
+			{
+				patterns: []string{"_3DES_"},
+				since:    date(2015, time.May, 1),
+				source:   "RFC 7525: cipher suites offering less than 128 bits of security should not be negotiated",
+			},
+			{
+				patterns: []string{"_3DES_"},
+				removal:  date(2021, time.August, 31),
+				source:   "Chrome 93 removes 3DES cipher suites",
+			},
+			{
+				patterns: []string{"TLS_RSA_WITH_", "TLS_RSA_PSK_WITH_", "TLS_RSA_EXPORT"},
+				since:    date(2022, time.November, 1),
+				source:   "RFC 9325: static RSA key exchange should not be negotiated",
+			},
+			{
+				patterns: []string{"TLS_RSA_WITH_"},
+				removal:  date(2024, time.February, 6),
+				source:   "Go 1.22 removes RSA key exchange cipher suites from the crypto/tls defaults",
+			},
+			{
+				patterns: []string{"TLS_DH_DSS_", "TLS_DH_RSA_", "TLS_ECDH_ECDSA_", "TLS_ECDH_RSA_"},
+				since:    date(2022, time.November, 1),
+				source:   "RFC 9325: static Diffie-Hellman key exchange should not be negotiated",
+			},
+			{
+				patterns: []string{"TLS_DHE_"},
+				since:    date(2022, time.November, 1),
+				source:   "RFC 9325: ephemeral finite-field Diffie-Hellman key exchange should not be negotiated",
+			},
+			{
+				patterns: []string{"TLS_DHE_RSA_", "TLS_DHE_DSS_"},
+				removal:  date(2016, time.August, 31),
+				source:   "Chrome 53 removes DHE cipher suites",
+			},
+			{
+				// These cipher suites were removed from TLS 1.2, leaving only the
+				// deprecated versions able to negotiate them.
+				patterns: []string{"_EXPORT", "_DES40_", "_DES_CBC_", "_IDEA_"},
+				since:    date(2021, time.March, 1),
+				source:   "RFC 8996: TLS 1.0 and TLS 1.1 are deprecated",
+			},
+			{
+				// Cipher suites named with a bare _SHA use HMAC-SHA-1.
+				suffixes: []string{"_SHA"},
+				removal:  date(2031, time.January, 1),
+				source:   "NIST SP 800-131A Rev. 3 (draft): SHA-1 is disallowed after 31 December 2030",
+			},
+		},
+	}
+}
+
+// Lookup returns the deprecation of the named cipher suite, or nil if it has
+// not been deprecated. When several rules apply, the earliest dates are used
+// and every source is recorded.
+func (s *DeprecationSchedule) Lookup(name string) *domain.Deprecation {
+	var d *domain.Deprecation
+	for _, rule := range s.rules {
+		if !rule.matches(name) {
+			continue
+		}
+		if d == nil {
+			d = &domain.Deprecation{}
+		}
+		if !rule.since.IsZero() && (d.Since.IsZero() || rule.since.Before(d.Since)) {
+			d.Since = rule.since
+		}
+		if !rule.removal.IsZero() && (d.Removal.IsZero() || rule.removal.Before(d.Removal)) {
+			d.Removal = rule.removal
+		}
+		d.Sources = append(d.Sources, rule.source)
+	}
+
+	// A removal implies deprecation, even if no document deprecated the cipher
+	// suite beforehand.
+	if d != nil && !d.Removal.IsZero() && (d.Since.IsZero() || d.Removal.Before(d.Since)) {
+		d.Since = d.Removal
+	}
+	return d
+}
+
+func (r deprecationRule) matches(name string) bool {
+	for _, p := range r.patterns {
+		if strings.Contains(name, p) {
+			return true
+		}
+	}
+	for _, s := range r.suffixes {
+		if strings.HasSuffix(name, s) {
+			return true
+		}
+	}
+	return false
+}
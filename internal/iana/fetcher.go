@@ -1,62 +1,275 @@
 package iana
 
 import (
-	"encoding/csv"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/tomasbasham/ciphersuites/internal/domain"
 )
 
+// Registry is the content of a fetched registry file.
+type Registry struct {
+	// Source is the URL the registry was fetched from. When the registry was
+	// read from the cache, it is the URL the cached copy was fetched from, and
+	// when it was read from a local copy, it is the path of that copy.
+	Source string
+
+	// SHA256 is the hex-encoded SHA-256 digest of the registry file.
+	SHA256 string
+
+	// Cached reports whether the registry was read from the cache, either
+	// because the cached copy was fresh, the server reported it unmodified,
+	// or every URL failed.
+	Cached bool
+
+	Suites []domain.CipherSuite
+}
+
+// ChecksumError is returned when a fetched registry does not match the
+// expected digest.
+type ChecksumError struct {
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("registry checksum mismatch: expected SHA-256 %s, got %s", e.Expected, e.Actual)
+}
+
+// statusError is returned for unsuccessful HTTP responses.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.code)
+}
+
 // Fetcher retrieves cipher suite data from IANA
 type Fetcher struct {
 	client *http.Client
 	parser *Parser
+
+	// Mirrors are tried in order when the primary URL cannot be fetched.
+	Mirrors []string
+
+	// Retries is the number of times a transient failure is retried for
+	// each URL.
+	Retries int
+
+	// Backoff is the delay before the first retry. It doubles with every
+	// subsequent retry.
+	Backoff time.Duration
+
+	// MaxSize is the largest registry file, in bytes, that is read from the
+	// server.
+	MaxSize int64
+
+	// CacheDir, if set, is a directory in which fetched registries are
+	// stored. A cached registry younger than CacheTTL is used without
+	// contacting the server, an older one is revalidated with a conditional
+	// request, and one of any age is used if every URL fails.
+	CacheDir string
+	CacheTTL time.Duration
 }
 
 // NewFetcher creates a new IANA fetcher
 func NewFetcher() *Fetcher {
 	return &Fetcher{
-		client: &http.Client{Timeout: 30 * time.Second},
-		parser: NewParser(),
+		client:   &http.Client{Timeout: 30 * time.Second},
+		parser:   NewParser(),
+		Retries:  3,
+		Backoff:  time.Second,
+		MaxSize:  8 << 20,
+		CacheTTL: 24 * time.Hour,
 	}
 }
 
 // FetchCipherSuites retrieves and parses cipher suites from the IANA registry
-func (f *Fetcher) FetchCipherSuites(url string) ([]domain.CipherSuite, error) {
-	resp, err := f.client.Get(url)
+func (f *Fetcher) FetchCipherSuites(ctx context.Context, url string) ([]domain.CipherSuite, error) {
+	registry, err := f.FetchRegistry(ctx, url, "")
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
+	return registry.Suites, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+// FetchRegistry retrieves the IANA registry, verifies it against the expected
+// hex-encoded SHA-256 digest, and parses its cipher suites. The digest is not
+// verified if expectedSHA256 is empty. Nothing is parsed from a registry that
+// fails verification, and it is never cached.
+func (f *Fetcher) FetchRegistry(ctx context.Context, url, expectedSHA256 string) (*Registry, error) {
+	cache := f.cache(url)
+	cached, _ := cache.load()
+	if cached != nil && verify(cached.body, expectedSHA256) != nil {
+		cached = nil
+	}
+	if cached != nil && time.Since(cached.FetchedAt) < f.CacheTTL {
+		return f.parse(cached.URL, cached.body, true)
 	}
 
-	reader := csv.NewReader(resp.Body)
+	var errs []string
+	for _, u := range append([]string{url}, f.Mirrors...) {
+		var conditional *cacheEntry
+		if cached != nil && cached.URL == u {
+			conditional = cached
+		}
 
-	// Skip header
-	if _, err := reader.Read(); err != nil {
-		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+		entry, err := f.fetchWithRetry(ctx, u, conditional)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = append(errs, fmt.Sprintf("%s: %v", u, err))
+			continue
+		}
+
+		notModified := entry == conditional
+		if err := verify(entry.body, expectedSHA256); err != nil {
+			return nil, fmt.Errorf("%s: %w", u, err)
+		}
+		_ = cache.store(entry)
+		return f.parse(u, entry.body, notModified)
 	}
 
-	var suites []domain.CipherSuite
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
+	if cached != nil {
+		return f.parse(cached.URL, cached.body, true)
+	}
+	return nil, fmt.Errorf("HTTP request failed: %s", strings.Join(errs, "; "))
+}
+
+// ReadRegistry reads a local copy of the registry, such as a committed
+// snapshot, verifies it against the expected hex-encoded SHA-256 digest, and
+// parses its cipher suites. The registry records path as its source, since
+// the local copy is not necessarily byte-for-byte what the IANA serves. The
+// digest is not verified if expectedSHA256 is empty.
+func (f *Fetcher) ReadRegistry(path, expectedSHA256 string) (*Registry, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := verify(body, expectedSHA256); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f.parse(path, body, false)
+}
+
+func (f *Fetcher) parse(source string, body []byte, cached bool) (*Registry, error) {
+	suites, err := f.parser.ParseCSV(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{Source: source, SHA256: checksum(body), Cached: cached, Suites: suites}, nil
+}
+
+// fetchWithRetry fetches url, retrying transient failures with exponential
+// backoff. If conditional is not nil, the request is made conditional on the
+// cached copy having changed, and conditional itself is returned, with an
+// updated fetch time, if it has not.
+func (f *Fetcher) fetchWithRetry(ctx context.Context, url string, conditional *cacheEntry) (*cacheEntry, error) {
+	delay := f.Backoff
+	for attempt := 0; ; attempt++ {
+		entry, err := f.fetch(ctx, url, conditional)
+		if err == nil || attempt >= f.Retries || !transient(err) {
+			return entry, err
 		}
-		if err != nil {
-			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		}
+		delay *= 2
+	}
+}
 
-		if suite, ok := f.parser.ParseRecord(record); ok {
-			suites = append(suites, suite)
+func (f *Fetcher) fetch(ctx context.Context, url string, conditional *cacheEntry) (*cacheEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if conditional != nil {
+		if conditional.ETag != "" {
+			req.Header.Set("If-None-Match", conditional.ETag)
+		}
+		if conditional.LastModified != "" {
+			req.Header.Set("If-Modified-Since", conditional.LastModified)
 		}
 	}
 
-	return suites, nil
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && conditional != nil {
+		conditional.FetchedAt = time.Now()
+		return conditional, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{code: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if int64(len(body)) > f.MaxSize {
+		return nil, fmt.Errorf("response exceeds %d bytes", f.MaxSize)
+	}
+
+	return &cacheEntry{
+		URL:          url,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		FetchedAt:    time.Now(),
+		body:         body,
+	}, nil
+}
+
+// transient reports whether a failed request is worth retrying: network
+// errors, server errors and rate limiting are, but other errors, such as
+// client errors or an oversized response, would only fail again.
+func transient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var status *statusError
+	if errors.As(err, &status) {
+		return status.code == http.StatusTooManyRequests || status.code >= 500
+	}
+
+	// Every error from the client is a *url.Error, which is itself a
+	// net.Error, so look at the error it wraps.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func verify(body []byte, expectedSHA256 string) error {
+	actual := checksum(body)
+	if expectedSHA256 != "" && !strings.EqualFold(actual, expectedSHA256) {
+		return &ChecksumError{Expected: strings.ToLower(expectedSHA256), Actual: actual}
+	}
+	return nil
+}
+
+func checksum(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
 }
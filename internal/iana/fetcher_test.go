@@ -0,0 +1,318 @@
+package iana_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/iana"
+)
+
+const registryCSV = `Value,Description,DTLS-OK,Recommended,Reference,Comment
+"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,Y,[RFC8446],
+"0x00,0x05",TLS_RSA_WITH_RC4_128_SHA,N,N,[RFC5246][RFC6347],
+`
+
+const etag = `"registry-v1"`
+
+// registryServer stands in for IANA. The first failures requests fail with
+// the given status, and every request is counted.
+type registryServer struct {
+	*httptest.Server
+	requests    int32
+	conditional int32
+}
+
+func newRegistryServer(t *testing.T, failures int, status int) *registryServer {
+	t.Helper()
+
+	s := &registryServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&s.requests, 1)
+		if int(n) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			atomic.AddInt32(&s.conditional, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(registryCSV))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newFetcher() *iana.Fetcher {
+	f := iana.NewFetcher()
+	f.Backoff = time.Millisecond
+	return f
+}
+
+func TestFetchRegistryChecksum(t *testing.T) {
+	t.Parallel()
+
+	server := newRegistryServer(t, 0, 0)
+
+	sum := sha256.Sum256([]byte(registryCSV))
+	checksum := hex.EncodeToString(sum[:])
+
+	var tests = map[string]struct {
+		expected string
+		wantErr  bool
+	}{
+		"accepts matching checksum": {
+			expected: checksum,
+		},
+		"accepts matching checksum in upper case": {
+			expected: strings.ToUpper(checksum),
+		},
+		"skips verification without checksum": {
+			expected: "",
+		},
+		"rejects mismatched checksum": {
+			expected: strings.Repeat("0", 64),
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			registry, err := newFetcher().FetchRegistry(context.Background(), server.URL, tt.expected)
+			if tt.wantErr {
+				var checksumErr *iana.ChecksumError
+				if !errors.As(err, &checksumErr) {
+					t.Fatalf("mismatch:\n  got:  %v\n  want: *iana.ChecksumError", err)
+				}
+				if checksumErr.Actual != checksum {
+					t.Errorf("mismatch:\n  got:  %s\n  want: %s", checksumErr.Actual, checksum)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if registry.SHA256 != checksum {
+				t.Errorf("mismatch:\n  got:  %s\n  want: %s", registry.SHA256, checksum)
+			}
+			if registry.Source != server.URL {
+				t.Errorf("mismatch:\n  got:  %s\n  want: %s", registry.Source, server.URL)
+			}
+			if len(registry.Suites) != 2 {
+				t.Errorf("mismatch:\n  got:  %d cipher suites\n  want: 2", len(registry.Suites))
+			}
+		})
+	}
+}
+
+func TestFetchRegistryRetries(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		failures     int
+		status       int
+		retries      int
+		wantErr      bool
+		wantRequests int32
+	}{
+		"retries server errors": {
+			failures:     2,
+			status:       http.StatusServiceUnavailable,
+			retries:      3,
+			wantRequests: 3,
+		},
+		"retries rate limiting": {
+			failures:     1,
+			status:       http.StatusTooManyRequests,
+			retries:      3,
+			wantRequests: 2,
+		},
+		"gives up after retries": {
+			failures:     5,
+			status:       http.StatusBadGateway,
+			retries:      2,
+			wantErr:      true,
+			wantRequests: 3,
+		},
+		"does not retry client errors": {
+			failures:     1,
+			status:       http.StatusNotFound,
+			retries:      3,
+			wantErr:      true,
+			wantRequests: 1,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newRegistryServer(t, tt.failures, tt.status)
+
+			f := newFetcher()
+			f.Retries = tt.retries
+			_, err := f.FetchRegistry(context.Background(), server.URL, "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %v", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(&server.requests); got != tt.wantRequests {
+				t.Errorf("mismatch:\n  got:  %d requests\n  want: %d requests", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestFetchRegistryRetriesDroppedConnections(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		_, _ = w.Write([]byte(registryCSV))
+	}))
+	t.Cleanup(server.Close)
+
+	if _, err := newFetcher().FetchRegistry(context.Background(), server.URL, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("mismatch:\n  got:  %d requests\n  want: 2 requests", got)
+	}
+}
+
+func TestFetchRegistryMaxSize(t *testing.T) {
+	t.Parallel()
+
+	server := newRegistryServer(t, 0, 0)
+
+	f := newFetcher()
+	f.MaxSize = int64(len(registryCSV)) - 1
+	if _, err := f.FetchRegistry(context.Background(), server.URL, ""); err == nil {
+		t.Error("expected error fetching oversized registry")
+	}
+	if got := atomic.LoadInt32(&server.requests); got != 1 {
+		t.Errorf("mismatch:\n  got:  %d requests\n  want: 1 request", got)
+	}
+
+	f.MaxSize = int64(len(registryCSV))
+	if _, err := f.FetchRegistry(context.Background(), server.URL, ""); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFetchRegistryMirrors(t *testing.T) {
+	t.Parallel()
+
+	primary := newRegistryServer(t, 1, http.StatusNotFound)
+	mirror := newRegistryServer(t, 0, 0)
+
+	f := newFetcher()
+	f.Mirrors = []string{mirror.URL}
+	registry, err := f.FetchRegistry(context.Background(), primary.URL, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registry.Source != mirror.URL {
+		t.Errorf("mismatch:\n  got:  %s\n  want: %s", registry.Source, mirror.URL)
+	}
+}
+
+func TestFetchRegistryCache(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		ttl             time.Duration
+		failSecond      bool
+		wantRequests    int32
+		wantConditional int32
+	}{
+		"uses fresh cache without contacting the server": {
+			ttl:          time.Hour,
+			wantRequests: 1,
+		},
+		"revalidates stale cache with a conditional request": {
+			ttl:             0,
+			wantRequests:    2,
+			wantConditional: 1,
+		},
+		"falls back to stale cache when the server fails": {
+			ttl:          0,
+			failSecond:   true,
+			wantRequests: 2,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newRegistryServer(t, 0, 0)
+			f := newFetcher()
+			f.Retries = 0
+			f.CacheDir = t.TempDir()
+			f.CacheTTL = tt.ttl
+
+			first, err := f.FetchRegistry(context.Background(), server.URL, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if first.Cached {
+				t.Error("expected first fetch not to be cached")
+			}
+
+			url := server.URL
+			if tt.failSecond {
+				server.Close()
+			}
+
+			second, err := f.FetchRegistry(context.Background(), url, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !second.Cached || second.SHA256 != first.SHA256 || len(second.Suites) != 2 {
+				t.Errorf("mismatch:\n  got:  %+v\n  want: the cached registry", second)
+			}
+
+			if got := atomic.LoadInt32(&server.requests); !tt.failSecond && got != tt.wantRequests {
+				t.Errorf("mismatch:\n  got:  %d requests\n  want: %d requests", got, tt.wantRequests)
+			}
+			if got := atomic.LoadInt32(&server.conditional); got != tt.wantConditional {
+				t.Errorf("mismatch:\n  got:  %d conditional requests\n  want: %d", got, tt.wantConditional)
+			}
+		})
+	}
+}
+
+func TestFetchRegistryCancelled(t *testing.T) {
+	t.Parallel()
+
+	server := newRegistryServer(t, 100, http.StatusServiceUnavailable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	f := newFetcher()
+	f.Retries = 100
+	f.Backoff = 10 * time.Millisecond
+	if _, err := f.FetchRegistry(ctx, server.URL, ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, context.DeadlineExceeded)
+	}
+}
@@ -1,6 +1,9 @@
 package iana
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
 	"strings"
 
 	"github.com/tomasbasham/ciphersuites/internal/domain"
@@ -8,30 +11,46 @@ import (
 
 // Parser converts IANA CSV records into domain models
 type Parser struct {
-	classifier *SecurityClassifier
+	classifier  *SecurityClassifier
+	deprecation *DeprecationSchedule
+	strength    *StrengthEstimator
 }
 
 // NewParser creates a new IANA record parser
 func NewParser() *Parser {
 	return &Parser{
-		classifier: NewSecurityClassifier(),
+		classifier:  NewSecurityClassifier(),
+		deprecation: NewDeprecationSchedule(),
+		strength:    NewStrengthEstimator(),
 	}
 }
 
-// ParseRecord converts a CSV record into a CipherSuite
-func (p *Parser) ParseRecord(record []string) (domain.CipherSuite, bool) {
-	if len(record) < 6 {
-		return domain.CipherSuite{}, false
+// ParseRecord converts a CSV record into a CipherSuite, locating each column
+// through the header. It returns false without an error for records that do
+// not describe a single cipher suite, such as reserved or unassigned ranges,
+// and an error for records that cannot be interpreted.
+func (p *Parser) ParseRecord(header Header, record []string) (domain.CipherSuite, bool, error) {
+	value := header.get(record, columnValue)
+	description := header.get(record, columnDescription)
+	dtlsOK := header.get(record, columnDTLSOK)
+	recommended := header.get(record, columnRecommended)
+
+	if len(record) != len(header.names) {
+		return domain.CipherSuite{}, false, fmt.Errorf("expected %d columns, found %d", len(header.names), len(record))
 	}
 
-	value := record[0]
-	description := record[1]
-	dtlsOK := record[2]
-	recommended := record[3]
-
 	// Skip reserved, unassigned, and ranges
 	if p.shouldSkip(description, value) {
-		return domain.CipherSuite{}, false
+		return domain.CipherSuite{}, false, nil
+	}
+
+	if !valuePattern.MatchString(value) {
+		return domain.CipherSuite{}, false, fmt.Errorf("invalid value %q", value)
+	}
+	switch recommended {
+	case "Y", "N", "D":
+	default:
+		return domain.CipherSuite{}, false, fmt.Errorf("invalid recommended flag %q", recommended)
 	}
 
 	security := p.classifier.Classify(recommended, dtlsOK, description)
@@ -39,13 +58,71 @@ func (p *Parser) ParseRecord(record []string) (domain.CipherSuite, bool) {
 	versions := p.determineTLSVersions(description, value)
 
 	return domain.CipherSuite{
-		Name:        description,
-		Protocol:    protocol,
-		Encryption:  encryption,
-		Hash:        hash,
-		Security:    security,
-		TLSVersions: versions,
-	}, true
+		Name:            description,
+		Value:           value,
+		Protocol:        protocol,
+		Encryption:      encryption,
+		Hash:            hash,
+		Security:        security,
+		TLSVersions:     versions,
+		SecurityBits:    p.strength.SecurityBits(description),
+		IANARecommended: recommended,
+		Reference:       header.get(record, columnReference),
+		Comment:         header.get(record, columnComment),
+		Extra:           header.extra(record),
+		Deprecation:     p.deprecation.Lookup(description),
+	}, true, nil
+}
+
+// ParseCSV reads the registry in CSV form. Columns are located by the names
+// in the header row, which must include every required column. Records that
+// do not describe a single cipher suite are skipped, but any record that
+// cannot be parsed fails the whole registry with a [*ParseError] listing
+// every such record.
+func (p *Parser) ParseCSV(r io.Reader) ([]domain.CipherSuite, error) {
+	reader := csv.NewReader(r)
+	// Records with the wrong number of fields are reported as diagnostics
+	// rather than aborting the read.
+	reader.FieldsPerRecord = -1
+
+	row, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	header, err := NewHeader(row)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		suites      []domain.CipherSuite
+		diagnostics []Diagnostic
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+		}
+
+		suite, ok, err := p.ParseRecord(header, record)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			diagnostics = append(diagnostics, Diagnostic{Line: line, Record: record, Reason: err.Error()})
+			continue
+		}
+		if ok {
+			suites = append(suites, suite)
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return nil, &ParseError{Diagnostics: diagnostics}
+	}
+
+	return suites, nil
 }
 
 func (p *Parser) shouldSkip(description, value string) bool {
@@ -0,0 +1,124 @@
+package iana_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/internal/iana"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		csv       string
+		wantNames []string
+		wantErr   bool
+	}{
+		"parses registry order": {
+			csv: `Value,Description,DTLS-OK,Recommended,Reference,Comment
+"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,Y,[RFC8446],
+"0x00,0x1C-1D",Reserved to avoid conflicts with SSLv3,,,[RFC5246],
+"0x00,0x05",TLS_RSA_WITH_RC4_128_SHA,N,N,[RFC5246],
+`,
+			wantNames: []string{"TLS_AES_128_GCM_SHA256", "TLS_RSA_WITH_RC4_128_SHA"},
+		},
+		"parses reordered columns": {
+			csv: `Description,Recommended,Comment,Value,Reference,DTLS-OK
+TLS_AES_128_GCM_SHA256,Y,,"0x13,0x01",[RFC8446],Y
+`,
+			wantNames: []string{"TLS_AES_128_GCM_SHA256"},
+		},
+		"tolerates byte order mark": {
+			csv: "\ufeffValue,Description,DTLS-OK,Recommended,Reference,Comment\n" +
+				`"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,Y,[RFC8446],` + "\n",
+			wantNames: []string{"TLS_AES_128_GCM_SHA256"},
+		},
+		"rejects missing column": {
+			csv: `Value,Description,DTLS-OK,Reference,Comment
+"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,[RFC8446],
+`,
+			wantErr: true,
+		},
+		"rejects duplicate column": {
+			csv: `Value,Description,DTLS-OK,Recommended,Recommended
+"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,Y,Y
+`,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			suites, err := iana.NewParser().ParseCSV(strings.NewReader(tt.csv))
+			if tt.wantErr {
+				var schemaErr *iana.SchemaError
+				if !errors.As(err, &schemaErr) {
+					t.Fatalf("mismatch:\n  got:  %v\n  want: *iana.SchemaError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var names []string
+			for _, s := range suites {
+				names = append(names, s.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantNames, ",") {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestParseCSVRetainsColumns(t *testing.T) {
+	t.Parallel()
+
+	csv := `Value,Description,DTLS-OK,Recommended,Reference,Comment,Status
+"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,Y,[RFC8446],Mandatory to implement,Active
+`
+	suites, err := iana.NewParser().ParseCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s := suites[0]
+	if s.Value != "0x13,0x01" || s.Reference != "[RFC8446]" || s.Comment != "Mandatory to implement" {
+		t.Errorf("mismatch:\n  got:  %q %q %q\n  want: registry columns", s.Value, s.Reference, s.Comment)
+	}
+	if s.Extra["Status"] != "Active" {
+		t.Errorf("mismatch:\n  got:  %v\n  want: map[Status:Active]", s.Extra)
+	}
+}
+
+func TestParseCSVDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	csv := `Value,Description,DTLS-OK,Recommended,Reference,Comment
+"0x13,0x01",TLS_AES_128_GCM_SHA256,Y,Y,[RFC8446],
+"0x13",TLS_AES_256_GCM_SHA384,Y,Y,[RFC8446],
+"0x13,0x03",TLS_CHACHA20_POLY1305_SHA256,Y,Maybe,[RFC8446],
+"0x13,0x04",TLS_AES_128_CCM_SHA256,Y
+`
+	_, err := iana.NewParser().ParseCSV(strings.NewReader(csv))
+
+	var parseErr *iana.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("mismatch:\n  got:  %v\n  want: *iana.ParseError", err)
+	}
+
+	wantLines := []int{3, 4, 5}
+	if len(parseErr.Diagnostics) != len(wantLines) {
+		t.Fatalf("mismatch:\n  got:  %v\n  want: %d diagnostics", parseErr.Diagnostics, len(wantLines))
+	}
+	for i, d := range parseErr.Diagnostics {
+		if d.Line != wantLines[i] {
+			t.Errorf("mismatch:\n  got:  line %d\n  want: line %d", d.Line, wantLines[i])
+		}
+	}
+}
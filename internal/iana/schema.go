@@ -0,0 +1,131 @@
+package iana
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Registry column names.
+const (
+	columnValue       = "Value"
+	columnDescription = "Description"
+	columnDTLSOK      = "DTLS-OK"
+	columnRecommended = "Recommended"
+	columnReference   = "Reference"
+	columnComment     = "Comment"
+)
+
+// requiredColumns must be present in the registry header.
+var requiredColumns = []string{columnValue, columnDescription, columnDTLSOK, columnRecommended}
+
+// valuePattern matches the value of a single cipher suite, such as 0x13,0x01.
+var valuePattern = regexp.MustCompile(`^0x[0-9A-Fa-f]{2},0x[0-9A-Fa-f]{2}$`)
+
+// Header maps the column names of the registry to their positions.
+type Header struct {
+	names   []string
+	indices map[string]int
+}
+
+// NewHeader validates the header row of the registry, returning a
+// [*SchemaError] if any required column is missing or a column is repeated.
+func NewHeader(row []string) (Header, error) {
+	h := Header{names: row, indices: make(map[string]int, len(row))}
+
+	var duplicate []string
+	for i, name := range row {
+		// Tolerate a byte order mark and stray whitespace around names.
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if _, ok := h.indices[name]; ok {
+			duplicate = append(duplicate, name)
+		}
+		h.indices[name] = i
+	}
+
+	var missing []string
+	for _, name := range requiredColumns {
+		if _, ok := h.indices[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 || len(duplicate) > 0 {
+		return Header{}, &SchemaError{Header: row, Missing: missing, Duplicate: duplicate}
+	}
+	return h, nil
+}
+
+// get returns the named column of record, or an empty string if the record
+// is too short to contain it.
+func (h Header) get(record []string, name string) string {
+	i, ok := h.indices[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// extra returns the columns of record that the parser does not interpret,
+// keyed by name.
+func (h Header) extra(record []string) map[string]string {
+	var extra map[string]string
+	for name, i := range h.indices {
+		switch name {
+		case columnValue, columnDescription, columnDTLSOK, columnRecommended, columnReference, columnComment:
+			continue
+		}
+		if i < len(record) && record[i] != "" {
+			if extra == nil {
+				extra = make(map[string]string)
+			}
+			extra[name] = record[i]
+		}
+	}
+	return extra
+}
+
+// SchemaError is returned when the registry header does not match the
+// expected schema.
+type SchemaError struct {
+	Header    []string
+	Missing   []string
+	Duplicate []string
+}
+
+func (e *SchemaError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing columns "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Duplicate) > 0 {
+		problems = append(problems, "duplicate columns "+strings.Join(e.Duplicate, ", "))
+	}
+	return fmt.Sprintf("unexpected registry header %q: %s", e.Header, strings.Join(problems, "; "))
+}
+
+// Diagnostic describes a record that could not be parsed.
+type Diagnostic struct {
+	Line   int
+	Record []string
+	Reason string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("line %d: %s: %q", d.Line, d.Reason, d.Record)
+}
+
+// ParseError is returned when one or more records of the registry could not
+// be parsed. It lists every such record, rather than only the first.
+type ParseError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *ParseError) Error() string {
+	lines := make([]string, 0, len(e.Diagnostics)+1)
+	lines = append(lines, fmt.Sprintf("%d registry records could not be parsed:", len(e.Diagnostics)))
+	for _, d := range e.Diagnostics {
+		lines = append(lines, "  "+d.String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,58 @@
+package iana
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/domain"
+)
+
+// snapshotDateLayout is the layout of snapshot file names, without the .csv
+// extension.
+const snapshotDateLayout = "2006-01-02"
+
+// LoadSnapshots reads every registry snapshot in dir. Snapshots are CSV files
+// named after the date they were taken, such as 2023-06-01.csv, and are
+// returned oldest first, each with the SHA-256 digest of its file.
+func LoadSnapshots(dir string) ([]domain.Snapshot, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.csv"))
+	if err != nil {
+		return nil, err
+	}
+
+	parser := NewParser()
+	var snapshots []domain.Snapshot
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".csv")
+		date, err := time.Parse(snapshotDateLayout, name)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s is not named YYYY-MM-DD.csv", path)
+		}
+
+		body, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		suites, err := parser.ParseCSV(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+		}
+
+		snapshots = append(snapshots, domain.Snapshot{Date: date, SHA256: checksum(body), Suites: suites})
+	}
+
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("no snapshots found in %s", dir)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Date.Before(snapshots[j].Date)
+	})
+
+	return snapshots, nil
+}
@@ -0,0 +1,101 @@
+package iana
+
+import (
+	"strconv"
+	"strings"
+)
+
+// StrengthEstimator determines the effective security strength of cipher
+// suites, in bits, from the algorithms named in them.
+type StrengthEstimator struct {
+	// keySizes gives the strength of ciphers whose name does not state their
+	// key size.
+	keySizes map[string]int
+}
+
+// NewStrengthEstimator creates a new strength estimator
+func NewStrengthEstimator() *StrengthEstimator {
+	return &StrengthEstimator{
+		keySizes: map[string]int{
+			"3DES":         112,
+			"DES":          56,
+			"DES40":        40,
+			"IDEA":         128,
+			"SEED":         128,
+			"SM4":          128,
+			"ASCONAEAD128": 128,
+			"CHACHA20":     256,
+			"KUZNYECHIK":   256,
+			"MAGMA":        256,
+			"28147":        256,
+		},
+	}
+}
+
+// SecurityBits returns the effective security strength of the named cipher
+// suite: the strength of its cipher, bounded by the length of its
+// authentication tag and by its key exchange where that is known from the
+// name alone.
+//
+// Cipher suites without encryption, with RC4, whose biases allow plaintext
+// recovery (RFC 7465), or with anonymous key exchange, which offers no
+// protection against an active attacker, have a strength of 0. Export key
+// exchange is bounded at 40 bits and CCM_8's 64-bit tag at 64 bits. The
+// strength of RSA, Diffie-Hellman and pre-shared key exchange depends on the
+// keys and groups in use, so does not bound the result.
+func (e *StrengthEstimator) SecurityBits(name string) int {
+	kex, cipher := splitName(name)
+	if strings.Contains(kex, "anon") || strings.Contains(kex, "NULL") {
+		return 0
+	}
+
+	bits := e.cipherBits(cipher)
+	if strings.Contains(kex, "EXPORT") && bits > 40 {
+		bits = 40
+	}
+	if strings.Contains(strings.Join(cipher, "_"), "CCM_8") && bits > 64 {
+		bits = 64
+	}
+	return bits
+}
+
+// splitName separates the key exchange and authentication algorithms of a
+// cipher suite name from its cipher and hash. TLS 1.3 cipher suites name no
+// key exchange.
+func splitName(name string) (kex string, cipher []string) {
+	name = strings.TrimPrefix(name, "TLS_")
+	if i := strings.Index(name, "_WITH_"); i >= 0 {
+		return name[:i], strings.Split(name[i+len("_WITH_"):], "_")
+	}
+	return "", strings.Split(name, "_")
+}
+
+func (e *StrengthEstimator) cipherBits(cipher []string) int {
+	switch cipher[0] {
+	case "NULL", "RC4":
+		return 0
+	case "AEGIS":
+		if len(cipher) > 1 && cipher[1] == "256" {
+			return 256
+		}
+		return 128
+	}
+
+	// Export variants state their reduced key size, such as DES_CBC_40.
+	for _, part := range cipher[1:] {
+		if bits, err := strconv.Atoi(part); err == nil && (bits == 40 || bits == 56) {
+			return bits
+		}
+	}
+	if bits, ok := e.keySizes[cipher[0]]; ok {
+		return bits
+	}
+
+	// AES, ARIA, CAMELLIA and RC2 state their key size.
+	if len(cipher) > 1 {
+		if bits, err := strconv.Atoi(cipher[1]); err == nil {
+			return bits
+		}
+	}
+	return 0
+}
@@ -0,0 +1,41 @@
+package iana_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/internal/iana"
+)
+
+func TestSecurityBits(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		name string
+		want int
+	}{
+		"TLS 1.3 AES 128":     {name: "TLS_AES_128_GCM_SHA256", want: 128},
+		"TLS 1.3 ChaCha20":    {name: "TLS_CHACHA20_POLY1305_SHA256", want: 256},
+		"AES 256":             {name: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", want: 256},
+		"3DES":                {name: "TLS_RSA_WITH_3DES_EDE_CBC_SHA", want: 112},
+		"DES":                 {name: "TLS_RSA_WITH_DES_CBC_SHA", want: 56},
+		"export cipher":       {name: "TLS_RSA_EXPORT_WITH_DES40_CBC_SHA", want: 40},
+		"export key exchange": {name: "TLS_RSA_EXPORT1024_WITH_DES_CBC_SHA", want: 40},
+		"export key size":     {name: "TLS_KRB5_EXPORT_WITH_RC2_CBC_40_SHA", want: 40},
+		"truncated tag":       {name: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8", want: 64},
+		"RC4":                 {name: "TLS_RSA_WITH_RC4_128_SHA", want: 0},
+		"no encryption":       {name: "TLS_RSA_WITH_NULL_SHA256", want: 0},
+		"anonymous":           {name: "TLS_DH_anon_WITH_AES_256_GCM_SHA384", want: 0},
+		"signalling value":    {name: "TLS_FALLBACK_SCSV", want: 0},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := iana.NewStrengthEstimator().SecurityBits(tt.name)
+			if got != tt.want {
+				t.Errorf("mismatch:\n  got:  %d\n  want: %d", got, tt.want)
+			}
+		})
+	}
+}
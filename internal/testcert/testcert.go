@@ -0,0 +1,135 @@
+// Package testcert provides keys and certificates for tests, including
+// self-signed certificates for in-process TLS servers. Keys are generated
+// once and shared, since generating RSA keys is slow.
+package testcert
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// ServerName is the DNS name the certificates are issued for.
+const ServerName = "example.test"
+
+var (
+	ecdsaKey = sync.OnceValue(func() *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		return key
+	})
+
+	rsaKey = sync.OnceValue(func() *rsa.PrivateKey {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+		return key
+	})
+
+	ed25519Key = sync.OnceValue(func() ed25519.PrivateKey {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		return key
+	})
+
+	ecdsaCert = sync.OnceValue(func() tls.Certificate { return serverCertificate(ECDSAKey()) })
+	rsaCert   = sync.OnceValue(func() tls.Certificate { return serverCertificate(RSAKey()) })
+)
+
+// ECDSAKey returns a P-256 key.
+func ECDSAKey() *ecdsa.PrivateKey {
+	return ecdsaKey()
+}
+
+// RSAKey returns a 2048-bit RSA key.
+func RSAKey() *rsa.PrivateKey {
+	return rsaKey()
+}
+
+// Ed25519Key returns an Ed25519 key.
+func Ed25519Key() ed25519.PrivateKey {
+	return ed25519Key()
+}
+
+// ECDSA returns a certificate with a P-256 key, usable with ECDHE_ECDSA and
+// TLS 1.3 cipher suites.
+func ECDSA() tls.Certificate {
+	return ecdsaCert()
+}
+
+// RSA returns a certificate with a 2048-bit RSA key, usable with RSA, ECDHE_RSA
+// and TLS 1.3 cipher suites.
+func RSA() tls.Certificate {
+	return rsaCert()
+}
+
+// SelfSigned returns a self-signed certificate for key, issued to ServerName.
+// A zero usage leaves out the key usage extension, allowing any use.
+func SelfSigned(key crypto.Signer, usage x509.KeyUsage) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: ServerName},
+		DNSNames:     []string{ServerName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     usage,
+	}
+	return create(template, template, key.Public(), key)
+}
+
+// Issued returns a certificate for key with the given common name, signed by
+// issuer.
+func Issued(key crypto.Signer, commonName string, issuer *x509.Certificate, issuerKey crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	return create(template, issuer, key.Public(), issuerKey)
+}
+
+func serverCertificate(key crypto.Signer) tls.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: ServerName},
+		DNSNames:     []string{ServerName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	leaf := create(template, template, key.Public(), key)
+
+	return tls.Certificate{
+		Certificate: [][]byte{leaf.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}
+}
+
+func create(template, parent *x509.Certificate, pub crypto.PublicKey, signer crypto.Signer) *x509.Certificate {
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signer)
+	if err != nil {
+		panic(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	return cert
+}
@@ -0,0 +1,204 @@
+// Package metrics counts the cipher suites negotiated by TLS connections and
+// exposes the counts in the Prometheus text exposition format and through
+// [expvar].
+//
+// A [Collector] is fed [tls.ConnectionState] values, either directly with
+// [Collector.Observe] or by installing [Collector.VerifyConnection] on a
+// server configuration. It has no dependencies beyond the standard library.
+package metrics
+
+import (
+	"crypto/tls"
+	"expvar"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+const (
+	metricName = "tls_handshakes_total"
+	metricHelp = "Number of TLS handshakes by negotiated cipher suite, protocol version and classification."
+)
+
+// Sample is the number of handshakes that negotiated a cipher suite and
+// protocol version.
+type Sample struct {
+	CipherSuite    string
+	Version        string
+	Classification ciphersuites.Classification
+	Count          uint64
+}
+
+// key identifies a counter. The cipher suite is named and classified at the
+// negotiated version when the handshake is observed, so that loading a new
+// dataset does not relabel the handshakes already counted.
+type key struct {
+	cipherSuite    string
+	version        uint16
+	classification ciphersuites.Classification
+}
+
+// Collector counts negotiated cipher suites. It is safe for concurrent use.
+type Collector struct {
+	mu     sync.Mutex
+	counts map[key]uint64
+}
+
+// NewCollector creates an empty collector.
+func NewCollector() *Collector {
+	return &Collector{counts: make(map[key]uint64)}
+}
+
+// Observe counts the cipher suite and version negotiated by a connection.
+// States without a negotiated cipher suite are ignored.
+func (c *Collector) Observe(state tls.ConnectionState) {
+	if state.CipherSuite == 0 {
+		return
+	}
+
+	k := key{
+		cipherSuite:    fmt.Sprintf("0x%04X", state.CipherSuite),
+		version:        state.Version,
+		classification: ciphersuites.Unknown,
+	}
+	if cs, ok := ciphersuites.GetCipherSuiteByID(state.CipherSuite); ok {
+		k.cipherSuite = cs.Name
+		k.classification = cs.ClassificationAt(state.Version)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[k]++
+}
+
+// VerifyConnection observes the connection and never returns an error, so it
+// can be used as, or called from, [tls.Config.VerifyConnection].
+func (c *Collector) VerifyConnection(state tls.ConnectionState) error {
+	c.Observe(state)
+	return nil
+}
+
+// Config returns a clone of cfg that observes every connection, calling the
+// original VerifyConnection callback, if any, afterwards.
+func (c *Collector) Config(cfg *tls.Config) *tls.Config {
+	cfg = cfg.Clone()
+	verify := cfg.VerifyConnection
+	cfg.VerifyConnection = func(state tls.ConnectionState) error {
+		c.Observe(state)
+		if verify != nil {
+			return verify(state)
+		}
+		return nil
+	}
+	return cfg
+}
+
+// Listener wraps inner in a TLS listener, in the same way as
+// [tls.NewListener], that observes every connection accepted through it.
+func (c *Collector) Listener(inner net.Listener, cfg *tls.Config) net.Listener {
+	return tls.NewListener(inner, c.Config(cfg))
+}
+
+// Samples returns the current counts, ordered by cipher suite name, then by
+// protocol version and then by classification. A cipher suite appears under
+// more than one classification if it was reclassified, for example by
+// [ciphersuites.LoadDataset], while handshakes were being counted.
+func (c *Collector) Samples() []Sample {
+	c.mu.Lock()
+	samples := make([]Sample, 0, len(c.counts))
+	for k, n := range c.counts {
+		samples = append(samples, Sample{
+			CipherSuite:    k.cipherSuite,
+			Version:        ciphersuites.VersionName(k.version),
+			Classification: k.classification,
+			Count:          n,
+		})
+	}
+	c.mu.Unlock()
+
+	sort.Slice(samples, func(i, j int) bool {
+		if samples[i].CipherSuite != samples[j].CipherSuite {
+			return samples[i].CipherSuite < samples[j].CipherSuite
+		}
+		if samples[i].Version != samples[j].Version {
+			return samples[i].Version < samples[j].Version
+		}
+		return samples[i].Classification < samples[j].Classification
+	})
+	return samples
+}
+
+// WritePrometheus writes the counts as a single counter in the Prometheus text
+// exposition format.
+func (c *Collector) WritePrometheus(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# HELP %s %s\n", metricName, metricHelp)
+	fmt.Fprintf(&b, "# TYPE %s counter\n", metricName)
+	for _, s := range c.Samples() {
+		fmt.Fprintf(&b, "%s{cipher_suite=\"%s\",version=\"%s\",classification=\"%s\"} %d\n",
+			metricName, escapeLabel(s.CipherSuite), escapeLabel(s.Version), s.Classification, s.Count)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ServeHTTP serves the counts in the Prometheus text exposition format, so
+// the collector can be mounted as a scrape endpoint.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = c.WritePrometheus(w)
+}
+
+// expvarValue is the structure published through expvar.
+type expvarValue struct {
+	Total            uint64            `json:"total"`
+	ByClassification map[string]uint64 `json:"by_classification"`
+	Samples          []expvarSample    `json:"samples"`
+}
+
+type expvarSample struct {
+	CipherSuite    string `json:"cipher_suite"`
+	Version        string `json:"version"`
+	Classification string `json:"classification"`
+	Count          uint64 `json:"count"`
+}
+
+// Var returns an [expvar.Var] reporting the counts, along with totals overall
+// and by classification.
+func (c *Collector) Var() expvar.Var {
+	return expvar.Func(func() interface{} {
+		v := expvarValue{
+			ByClassification: make(map[string]uint64),
+			Samples:          []expvarSample{},
+		}
+		for _, s := range c.Samples() {
+			v.Total += s.Count
+			v.ByClassification[s.Classification.String()] += s.Count
+			v.Samples = append(v.Samples, expvarSample{
+				CipherSuite:    s.CipherSuite,
+				Version:        s.Version,
+				Classification: s.Classification.String(),
+				Count:          s.Count,
+			})
+		}
+		return v
+	})
+}
+
+// Publish publishes the counts through expvar under name. Like
+// [expvar.Publish], it panics if name is already in use.
+func (c *Collector) Publish(name string) {
+	expvar.Publish(name, c.Var())
+}
+
+// escapeLabel escapes a label value as required by the text exposition
+// format.
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
@@ -0,0 +1,128 @@
+package metrics_test
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/internal/testcert"
+	"github.com/tomasbasham/ciphersuites/metrics"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	t.Parallel()
+
+	c := metrics.NewCollector()
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS13, CipherSuite: tls.TLS_AES_128_GCM_SHA256})
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS13, CipherSuite: tls.TLS_AES_128_GCM_SHA256})
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA})
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: 0x0016})
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS11, CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA})
+	c.Observe(tls.ConnectionState{})
+
+	var b strings.Builder
+	if err := c.WritePrometheus(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `# HELP tls_handshakes_total Number of TLS handshakes by negotiated cipher suite, protocol version and classification.
+# TYPE tls_handshakes_total counter
+tls_handshakes_total{cipher_suite="TLS_AES_128_GCM_SHA256",version="TLS1.3",classification="recommended"} 2
+tls_handshakes_total{cipher_suite="TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA",version="TLS1.2",classification="insecure"} 1
+tls_handshakes_total{cipher_suite="TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",version="TLS1.1",classification="insecure"} 1
+tls_handshakes_total{cipher_suite="TLS_ECDHE_RSA_WITH_RC4_128_SHA",version="TLS1.2",classification="insecure"} 1
+`
+	if got := b.String(); got != want {
+		t.Errorf("mismatch:\n  got:  %s\n  want: %s", got, want)
+	}
+}
+
+// TestClassificationAtObservation does not run in parallel because it
+// replaces the data used by every other test.
+func TestClassificationAtObservation(t *testing.T) {
+	defer ciphersuites.ResetDataset()
+
+	c := metrics.NewCollector()
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS13, CipherSuite: tls.TLS_AES_128_GCM_SHA256})
+
+	dataset := `{"version": 1, "cipher_suites": [{"id": "0x1301", "name": "TLS_AES_128_GCM_SHA256", "classification": "weak", "tls_versions": ["TLS1.3"]}]}`
+	if err := ciphersuites.LoadDataset(strings.NewReader(dataset)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS13, CipherSuite: tls.TLS_AES_128_GCM_SHA256})
+
+	want := []metrics.Sample{
+		{CipherSuite: "TLS_AES_128_GCM_SHA256", Version: "TLS1.3", Classification: ciphersuites.Recommended, Count: 1},
+		{CipherSuite: "TLS_AES_128_GCM_SHA256", Version: "TLS1.3", Classification: ciphersuites.Weak, Count: 1},
+	}
+	if got := c.Samples(); !reflect.DeepEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: %+v", got, want)
+	}
+}
+
+func TestVar(t *testing.T) {
+	t.Parallel()
+
+	c := metrics.NewCollector()
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA})
+	c.Observe(tls.ConnectionState{Version: tls.VersionTLS13, CipherSuite: tls.TLS_AES_256_GCM_SHA384})
+
+	var got struct {
+		Total            uint64            `json:"total"`
+		ByClassification map[string]uint64 `json:"by_classification"`
+	}
+	if err := json.Unmarshal([]byte(c.Var().String()), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	if got.Total != 2 || got.ByClassification["weak"] != 1 || got.ByClassification["recommended"] != 1 {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: total 2, one weak and one recommended", got)
+	}
+}
+
+func TestListener(t *testing.T) {
+	t.Parallel()
+
+	c := metrics.NewCollector()
+
+	inner, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := c.Listener(inner, &tls.Config{Certificates: []tls.Certificate{testcert.ECDSA()}})
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	conn, err := tls.Dial("tcp", inner.Addr().String(), &tls.Config{
+		ServerName:         testcert.ServerName,
+		InsecureSkipVerify: true,
+		MaxVersion:         tls.VersionTLS12,
+		CipherSuites:       []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	conn.Close()
+
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	want := `tls_handshakes_total{cipher_suite="TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",version="TLS1.2",classification="recommended"} 1`
+	if got := rec.Body.String(); !strings.Contains(got, want) {
+		t.Errorf("missing %q in:\n%s", want, got)
+	}
+}
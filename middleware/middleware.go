@@ -0,0 +1,166 @@
+// Package middleware provides net/http middleware that enforces a minimum
+// cipher suite classification on requests received over TLS.
+//
+// The middleware inspects the negotiated protocol version and cipher suite
+// from [http.Request.TLS], classifies them, and then rejects, logs or simply
+// annotates requests that fall below the configured minimum. Connections are
+// classified with [ciphersuites.CipherSuite.ClassificationAt], so a cipher
+// suite negotiated over TLS 1.1 or earlier, which RFC 8996 deprecates, or over
+// a version it is not defined for, is classified as insecure.
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// Mode determines how the middleware treats requests whose cipher suite falls
+// below the minimum classification.
+type Mode int
+
+const (
+	// Enforce rejects requests below the minimum classification.
+	Enforce Mode = iota
+	// Report logs requests below the minimum classification and then passes
+	// them to the next handler.
+	Report
+	// Tag passes every request to the next handler without logging. The
+	// assessment is still available through [FromContext].
+	Tag
+)
+
+// Assessment describes the TLS parameters of a request and how they compare
+// to the configured minimum classification.
+type Assessment struct {
+	// Version is the negotiated protocol version, or zero for plaintext
+	// requests.
+	Version uint16
+	// CipherSuite is the negotiated cipher suite identifier, or zero for
+	// plaintext requests.
+	CipherSuite uint16
+	// Name is the IANA name of the cipher suite.
+	Name           string
+	Classification ciphersuites.Classification
+
+	// Allowed reports whether the classification meets the minimum.
+	Allowed bool
+}
+
+// Config configures the middleware.
+type Config struct {
+	// MinClassification is the weakest classification that is allowed. It
+	// defaults to [ciphersuites.Secure]. Requests over plaintext HTTP, or
+	// using a cipher suite that cannot be classified, are treated as
+	// [ciphersuites.Unknown] and are never allowed.
+	MinClassification ciphersuites.Classification
+
+	// Mode determines what happens to requests below the minimum.
+	Mode Mode
+
+	// StatusCode is the status returned when rejecting a request in [Enforce]
+	// mode. It defaults to [http.StatusUpgradeRequired].
+	StatusCode int
+
+	// Reject, if set, writes the response for rejected requests instead of
+	// the default plain text error.
+	Reject func(w http.ResponseWriter, r *http.Request, a Assessment)
+
+	// OnViolation, if set, is called for every request below the minimum in
+	// any mode. When unset, [Report] mode logs violations with the standard
+	// logger.
+	OnViolation func(r *http.Request, a Assessment)
+}
+
+// Middleware enforces a minimum cipher suite classification.
+type Middleware struct {
+	config Config
+}
+
+// New creates a new middleware from the given configuration.
+func New(config Config) *Middleware {
+	if config.MinClassification == ciphersuites.Unknown {
+		config.MinClassification = ciphersuites.Secure
+	}
+	if config.StatusCode == 0 {
+		config.StatusCode = http.StatusUpgradeRequired
+	}
+	return &Middleware{config: config}
+}
+
+// Handler wraps next so that every request is assessed before it is served.
+func (m *Middleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := m.Assess(r.TLS)
+		r = r.WithContext(context.WithValue(r.Context(), assessmentKey{}, a))
+
+		if !a.Allowed {
+			m.violation(r, a)
+			if m.config.Mode == Enforce {
+				m.reject(w, r, a)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Assess classifies the TLS parameters of a connection against the minimum
+// classification. A nil state represents a plaintext connection.
+func (m *Middleware) Assess(state *tls.ConnectionState) Assessment {
+	a := Assessment{Classification: ciphersuites.Unknown}
+	if state != nil {
+		a.Version = state.Version
+		a.CipherSuite = state.CipherSuite
+		a.Name = fmt.Sprintf("0x%04X", state.CipherSuite)
+		if cs, ok := ciphersuites.GetCipherSuiteByID(state.CipherSuite); ok {
+			a.Name = cs.Name
+			a.Classification = cs.ClassificationAt(state.Version)
+		}
+	}
+	a.Allowed = a.Classification.AtLeast(m.config.MinClassification)
+	return a
+}
+
+func (m *Middleware) violation(r *http.Request, a Assessment) {
+	if m.config.OnViolation != nil {
+		m.config.OnViolation(r, a)
+		return
+	}
+	if m.config.Mode != Report {
+		return
+	}
+	if a.Version == 0 {
+		log.Printf("request %s %s from %s was not made over TLS", r.Method, r.URL.Path, r.RemoteAddr)
+		return
+	}
+	log.Printf("request %s %s from %s negotiated %s cipher suite %s over %s",
+		r.Method, r.URL.Path, r.RemoteAddr, a.Classification, a.Name, ciphersuites.VersionName(a.Version))
+}
+
+func (m *Middleware) reject(w http.ResponseWriter, r *http.Request, a Assessment) {
+	if m.config.Reject != nil {
+		m.config.Reject(w, r, a)
+		return
+	}
+	if m.config.StatusCode == http.StatusUpgradeRequired {
+		// RFC 9110 requires a 426 response to list the protocols to upgrade to.
+		w.Header().Set("Upgrade", "TLS/1.3")
+		w.Header().Set("Connection", "Upgrade")
+	}
+	http.Error(w, "connection cipher suite does not meet security policy", m.config.StatusCode)
+}
+
+type assessmentKey struct{}
+
+// FromContext returns the assessment recorded by the middleware for the
+// request the context belongs to.
+func FromContext(ctx context.Context) (Assessment, bool) {
+	a, ok := ctx.Value(assessmentKey{}).(Assessment)
+	return a, ok
+}
@@ -0,0 +1,217 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/middleware"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		config      middleware.Config
+		cipherSuite uint16
+		wantStatus  int
+		wantTagged  ciphersuites.Classification
+	}{
+		"allows recommended suite": {
+			config:      middleware.Config{MinClassification: ciphersuites.Secure},
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			wantStatus:  http.StatusOK,
+			wantTagged:  ciphersuites.Recommended,
+		},
+		"rejects weak suite": {
+			config:      middleware.Config{MinClassification: ciphersuites.Secure},
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+			wantStatus:  http.StatusUpgradeRequired,
+		},
+		"rejects with custom status": {
+			config:      middleware.Config{MinClassification: ciphersuites.Secure, StatusCode: http.StatusForbidden},
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+			wantStatus:  http.StatusForbidden,
+		},
+		"rejects with custom response": {
+			config: middleware.Config{
+				MinClassification: ciphersuites.Secure,
+				Reject: func(w http.ResponseWriter, r *http.Request, a middleware.Assessment) {
+					w.WriteHeader(http.StatusTeapot)
+				},
+			},
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+			wantStatus:  http.StatusTeapot,
+		},
+		"tags weak suite": {
+			config:      middleware.Config{MinClassification: ciphersuites.Secure, Mode: middleware.Tag},
+			cipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+			wantStatus:  http.StatusOK,
+			wantTagged:  ciphersuites.Weak,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var tagged ciphersuites.Classification
+			handler := middleware.New(tt.config).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if a, ok := middleware.FromContext(r.Context()); ok {
+					tagged = a.Classification
+				}
+			}))
+
+			status := get(t, handler, tt.cipherSuite)
+			if status != tt.wantStatus {
+				t.Errorf("mismatch:\n  got:  %d\n  want: %d", status, tt.wantStatus)
+			}
+			if tagged != tt.wantTagged {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", tagged, tt.wantTagged)
+			}
+		})
+	}
+}
+
+func TestAssess(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		min   ciphersuites.Classification
+		state *tls.ConnectionState
+		want  ciphersuites.Classification
+		allow bool
+	}{
+		"allows recommended suite by default": {
+			state: &tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			want:  ciphersuites.Recommended,
+			allow: true,
+		},
+		"rejects weak suite by default": {
+			state: &tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+			want:  ciphersuites.Weak,
+		},
+		"classifies deprecated version as insecure": {
+			min:   ciphersuites.Weak,
+			state: &tls.ConnectionState{Version: tls.VersionTLS11, CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+			want:  ciphersuites.Insecure,
+		},
+		"classifies suite over undefined version as insecure": {
+			min:   ciphersuites.Weak,
+			state: &tls.ConnectionState{Version: tls.VersionTLS10, CipherSuite: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			want:  ciphersuites.Insecure,
+		},
+		"classifies TLS 1.3 suite over TLS 1.2 as insecure": {
+			min:   ciphersuites.Weak,
+			state: &tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: tls.TLS_AES_128_GCM_SHA256},
+			want:  ciphersuites.Insecure,
+		},
+		"rejects unknown suite": {
+			min:   ciphersuites.Insecure,
+			state: &tls.ConnectionState{Version: tls.VersionTLS12, CipherSuite: 0xFFFF},
+			want:  ciphersuites.Unknown,
+		},
+		"rejects plaintext": {
+			min:  ciphersuites.Insecure,
+			want: ciphersuites.Unknown,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := middleware.New(middleware.Config{MinClassification: tt.min}).Assess(tt.state)
+			if a.Classification != tt.want {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", a.Classification, tt.want)
+			}
+			if a.Allowed != tt.allow {
+				t.Errorf("mismatch:\n  got:  %t\n  want: %t", a.Allowed, tt.allow)
+			}
+		})
+	}
+}
+
+func TestHandlerReportsViolations(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu         sync.Mutex
+		violations []middleware.Assessment
+	)
+	handler := middleware.New(middleware.Config{
+		MinClassification: ciphersuites.Recommended,
+		Mode:              middleware.Report,
+		OnViolation: func(r *http.Request, a middleware.Assessment) {
+			mu.Lock()
+			defer mu.Unlock()
+			violations = append(violations, a)
+		},
+	}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	if status := get(t, handler, tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA); status != http.StatusOK {
+		t.Errorf("mismatch:\n  got:  %d\n  want: %d", status, http.StatusOK)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(violations) != 1 {
+		t.Fatalf("mismatch:\n  got:  %d violations\n  want: 1 violation", len(violations))
+	}
+
+	want := middleware.Assessment{
+		Version:        tls.VersionTLS12,
+		CipherSuite:    tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		Name:           "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+		Classification: ciphersuites.Weak,
+		Allowed:        false,
+	}
+	if violations[0] != want {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: %+v", violations[0], want)
+	}
+}
+
+func TestHandlerRejectsPlaintext(t *testing.T) {
+	t.Parallel()
+
+	handler := middleware.New(middleware.Config{
+		MinClassification: ciphersuites.Weak,
+	}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusUpgradeRequired {
+		t.Errorf("mismatch:\n  got:  %d\n  want: %d", rec.Code, http.StatusUpgradeRequired)
+	}
+	if got := rec.Header().Get("Upgrade"); got != "TLS/1.3" {
+		t.Errorf("mismatch:\n  got:  %q\n  want: %q", got, "TLS/1.3")
+	}
+}
+
+// get serves handler over TLS and issues a request that can only negotiate
+// the given TLS 1.2 cipher suite, returning the response status code.
+func get(t *testing.T, handler http.Handler, cipherSuite uint16) int {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{CipherSuites: []uint16{cipherSuite}}
+	server.StartTLS()
+	defer server.Close()
+
+	client := server.Client()
+	transport := client.Transport.(*http.Transport)
+	transport.TLSClientConfig.MaxVersion = tls.VersionTLS12
+	transport.TLSClientConfig.CipherSuites = []uint16{cipherSuite}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
@@ -0,0 +1,263 @@
+package ciphersuites
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cipherSuiteName pairs the IANA name of a cipher suite with the name used by
+// OpenSSL.
+type cipherSuiteName struct {
+	name    string
+	openssl string
+}
+
+// cipherSuiteNames lists the cipher suites known to OpenSSL. It was produced
+// from the output of
+//
+//	openssl ciphers -stdname -V 'ALL:COMPLEMENTOFALL:@SECLEVEL=0'
+//
+// using OpenSSL 3.0, with the RC4 and 3DES cipher suites of earlier releases
+// and the TLS 1.3 CCM cipher suites, which OpenSSL implements but does not
+// enable by default, added. TLS 1.3 cipher suites use their IANA names in
+// OpenSSL too. Identifiers are taken from the registry, so only the names
+// OpenSSL uses are listed here.
+var cipherSuiteNames = []cipherSuiteName{
+	{"TLS_RSA_WITH_NULL_MD5", "NULL-MD5"},
+	{"TLS_RSA_WITH_NULL_SHA", "NULL-SHA"},
+	{"TLS_RSA_WITH_RC4_128_MD5", "RC4-MD5"},
+	{"TLS_RSA_WITH_RC4_128_SHA", "RC4-SHA"},
+	{"TLS_RSA_WITH_3DES_EDE_CBC_SHA", "DES-CBC3-SHA"},
+	{"TLS_PSK_WITH_NULL_SHA", "PSK-NULL-SHA"},
+	{"TLS_DHE_PSK_WITH_NULL_SHA", "DHE-PSK-NULL-SHA"},
+	{"TLS_RSA_PSK_WITH_NULL_SHA", "RSA-PSK-NULL-SHA"},
+	{"TLS_RSA_WITH_AES_128_CBC_SHA", "AES128-SHA"},
+	{"TLS_DHE_DSS_WITH_AES_128_CBC_SHA", "DHE-DSS-AES128-SHA"},
+	{"TLS_DHE_RSA_WITH_AES_128_CBC_SHA", "DHE-RSA-AES128-SHA"},
+	{"TLS_DH_anon_WITH_AES_128_CBC_SHA", "ADH-AES128-SHA"},
+	{"TLS_RSA_WITH_AES_256_CBC_SHA", "AES256-SHA"},
+	{"TLS_DHE_DSS_WITH_AES_256_CBC_SHA", "DHE-DSS-AES256-SHA"},
+	{"TLS_DHE_RSA_WITH_AES_256_CBC_SHA", "DHE-RSA-AES256-SHA"},
+	{"TLS_DH_anon_WITH_AES_256_CBC_SHA", "ADH-AES256-SHA"},
+	{"TLS_RSA_WITH_NULL_SHA256", "NULL-SHA256"},
+	{"TLS_RSA_WITH_AES_128_CBC_SHA256", "AES128-SHA256"},
+	{"TLS_RSA_WITH_AES_256_CBC_SHA256", "AES256-SHA256"},
+	{"TLS_DHE_DSS_WITH_AES_128_CBC_SHA256", "DHE-DSS-AES128-SHA256"},
+	{"TLS_RSA_WITH_CAMELLIA_128_CBC_SHA", "CAMELLIA128-SHA"},
+	{"TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA", "DHE-DSS-CAMELLIA128-SHA"},
+	{"TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA", "DHE-RSA-CAMELLIA128-SHA"},
+	{"TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA", "ADH-CAMELLIA128-SHA"},
+	{"TLS_DHE_RSA_WITH_AES_128_CBC_SHA256", "DHE-RSA-AES128-SHA256"},
+	{"TLS_DHE_DSS_WITH_AES_256_CBC_SHA256", "DHE-DSS-AES256-SHA256"},
+	{"TLS_DHE_RSA_WITH_AES_256_CBC_SHA256", "DHE-RSA-AES256-SHA256"},
+	{"TLS_DH_anon_WITH_AES_128_CBC_SHA256", "ADH-AES128-SHA256"},
+	{"TLS_DH_anon_WITH_AES_256_CBC_SHA256", "ADH-AES256-SHA256"},
+	{"TLS_RSA_WITH_CAMELLIA_256_CBC_SHA", "CAMELLIA256-SHA"},
+	{"TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA", "DHE-DSS-CAMELLIA256-SHA"},
+	{"TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA", "DHE-RSA-CAMELLIA256-SHA"},
+	{"TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA", "ADH-CAMELLIA256-SHA"},
+	{"TLS_PSK_WITH_AES_128_CBC_SHA", "PSK-AES128-CBC-SHA"},
+	{"TLS_PSK_WITH_AES_256_CBC_SHA", "PSK-AES256-CBC-SHA"},
+	{"TLS_DHE_PSK_WITH_AES_128_CBC_SHA", "DHE-PSK-AES128-CBC-SHA"},
+	{"TLS_DHE_PSK_WITH_AES_256_CBC_SHA", "DHE-PSK-AES256-CBC-SHA"},
+	{"TLS_RSA_PSK_WITH_AES_128_CBC_SHA", "RSA-PSK-AES128-CBC-SHA"},
+	{"TLS_RSA_PSK_WITH_AES_256_CBC_SHA", "RSA-PSK-AES256-CBC-SHA"},
+	{"TLS_RSA_WITH_AES_128_GCM_SHA256", "AES128-GCM-SHA256"},
+	{"TLS_RSA_WITH_AES_256_GCM_SHA384", "AES256-GCM-SHA384"},
+	{"TLS_DHE_RSA_WITH_AES_128_GCM_SHA256", "DHE-RSA-AES128-GCM-SHA256"},
+	{"TLS_DHE_RSA_WITH_AES_256_GCM_SHA384", "DHE-RSA-AES256-GCM-SHA384"},
+	{"TLS_DHE_DSS_WITH_AES_128_GCM_SHA256", "DHE-DSS-AES128-GCM-SHA256"},
+	{"TLS_DHE_DSS_WITH_AES_256_GCM_SHA384", "DHE-DSS-AES256-GCM-SHA384"},
+	{"TLS_DH_anon_WITH_AES_128_GCM_SHA256", "ADH-AES128-GCM-SHA256"},
+	{"TLS_DH_anon_WITH_AES_256_GCM_SHA384", "ADH-AES256-GCM-SHA384"},
+	{"TLS_PSK_WITH_AES_128_GCM_SHA256", "PSK-AES128-GCM-SHA256"},
+	{"TLS_PSK_WITH_AES_256_GCM_SHA384", "PSK-AES256-GCM-SHA384"},
+	{"TLS_DHE_PSK_WITH_AES_128_GCM_SHA256", "DHE-PSK-AES128-GCM-SHA256"},
+	{"TLS_DHE_PSK_WITH_AES_256_GCM_SHA384", "DHE-PSK-AES256-GCM-SHA384"},
+	{"TLS_RSA_PSK_WITH_AES_128_GCM_SHA256", "RSA-PSK-AES128-GCM-SHA256"},
+	{"TLS_RSA_PSK_WITH_AES_256_GCM_SHA384", "RSA-PSK-AES256-GCM-SHA384"},
+	{"TLS_PSK_WITH_AES_128_CBC_SHA256", "PSK-AES128-CBC-SHA256"},
+	{"TLS_PSK_WITH_AES_256_CBC_SHA384", "PSK-AES256-CBC-SHA384"},
+	{"TLS_PSK_WITH_NULL_SHA256", "PSK-NULL-SHA256"},
+	{"TLS_PSK_WITH_NULL_SHA384", "PSK-NULL-SHA384"},
+	{"TLS_DHE_PSK_WITH_AES_128_CBC_SHA256", "DHE-PSK-AES128-CBC-SHA256"},
+	{"TLS_DHE_PSK_WITH_AES_256_CBC_SHA384", "DHE-PSK-AES256-CBC-SHA384"},
+	{"TLS_DHE_PSK_WITH_NULL_SHA256", "DHE-PSK-NULL-SHA256"},
+	{"TLS_DHE_PSK_WITH_NULL_SHA384", "DHE-PSK-NULL-SHA384"},
+	{"TLS_RSA_PSK_WITH_AES_128_CBC_SHA256", "RSA-PSK-AES128-CBC-SHA256"},
+	{"TLS_RSA_PSK_WITH_AES_256_CBC_SHA384", "RSA-PSK-AES256-CBC-SHA384"},
+	{"TLS_RSA_PSK_WITH_NULL_SHA256", "RSA-PSK-NULL-SHA256"},
+	{"TLS_RSA_PSK_WITH_NULL_SHA384", "RSA-PSK-NULL-SHA384"},
+	{"TLS_RSA_WITH_CAMELLIA_128_CBC_SHA256", "CAMELLIA128-SHA256"},
+	{"TLS_DHE_DSS_WITH_CAMELLIA_128_CBC_SHA256", "DHE-DSS-CAMELLIA128-SHA256"},
+	{"TLS_DHE_RSA_WITH_CAMELLIA_128_CBC_SHA256", "DHE-RSA-CAMELLIA128-SHA256"},
+	{"TLS_DH_anon_WITH_CAMELLIA_128_CBC_SHA256", "ADH-CAMELLIA128-SHA256"},
+	{"TLS_RSA_WITH_CAMELLIA_256_CBC_SHA256", "CAMELLIA256-SHA256"},
+	{"TLS_DHE_DSS_WITH_CAMELLIA_256_CBC_SHA256", "DHE-DSS-CAMELLIA256-SHA256"},
+	{"TLS_DHE_RSA_WITH_CAMELLIA_256_CBC_SHA256", "DHE-RSA-CAMELLIA256-SHA256"},
+	{"TLS_DH_anon_WITH_CAMELLIA_256_CBC_SHA256", "ADH-CAMELLIA256-SHA256"},
+	{"TLS_AES_128_GCM_SHA256", "TLS_AES_128_GCM_SHA256"},
+	{"TLS_AES_256_GCM_SHA384", "TLS_AES_256_GCM_SHA384"},
+	{"TLS_CHACHA20_POLY1305_SHA256", "TLS_CHACHA20_POLY1305_SHA256"},
+	{"TLS_AES_128_CCM_SHA256", "TLS_AES_128_CCM_SHA256"},
+	{"TLS_AES_128_CCM_8_SHA256", "TLS_AES_128_CCM_8_SHA256"},
+	{"TLS_ECDHE_ECDSA_WITH_NULL_SHA", "ECDHE-ECDSA-NULL-SHA"},
+	{"TLS_ECDHE_ECDSA_WITH_RC4_128_SHA", "ECDHE-ECDSA-RC4-SHA"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA", "ECDHE-ECDSA-AES128-SHA"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA", "ECDHE-ECDSA-AES256-SHA"},
+	{"TLS_ECDHE_RSA_WITH_NULL_SHA", "ECDHE-RSA-NULL-SHA"},
+	{"TLS_ECDHE_RSA_WITH_RC4_128_SHA", "ECDHE-RSA-RC4-SHA"},
+	{"TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA", "ECDHE-RSA-DES-CBC3-SHA"},
+	{"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA", "ECDHE-RSA-AES128-SHA"},
+	{"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA", "ECDHE-RSA-AES256-SHA"},
+	{"TLS_ECDH_anon_WITH_NULL_SHA", "AECDH-NULL-SHA"},
+	{"TLS_ECDH_anon_WITH_AES_128_CBC_SHA", "AECDH-AES128-SHA"},
+	{"TLS_ECDH_anon_WITH_AES_256_CBC_SHA", "AECDH-AES256-SHA"},
+	{"TLS_SRP_SHA_WITH_AES_128_CBC_SHA", "SRP-AES-128-CBC-SHA"},
+	{"TLS_SRP_SHA_RSA_WITH_AES_128_CBC_SHA", "SRP-RSA-AES-128-CBC-SHA"},
+	{"TLS_SRP_SHA_DSS_WITH_AES_128_CBC_SHA", "SRP-DSS-AES-128-CBC-SHA"},
+	{"TLS_SRP_SHA_WITH_AES_256_CBC_SHA", "SRP-AES-256-CBC-SHA"},
+	{"TLS_SRP_SHA_RSA_WITH_AES_256_CBC_SHA", "SRP-RSA-AES-256-CBC-SHA"},
+	{"TLS_SRP_SHA_DSS_WITH_AES_256_CBC_SHA", "SRP-DSS-AES-256-CBC-SHA"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256", "ECDHE-ECDSA-AES128-SHA256"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA384", "ECDHE-ECDSA-AES256-SHA384"},
+	{"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256", "ECDHE-RSA-AES128-SHA256"},
+	{"TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA384", "ECDHE-RSA-AES256-SHA384"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "ECDHE-ECDSA-AES128-GCM-SHA256"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384", "ECDHE-ECDSA-AES256-GCM-SHA384"},
+	{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "ECDHE-RSA-AES128-GCM-SHA256"},
+	{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "ECDHE-RSA-AES256-GCM-SHA384"},
+	{"TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA", "ECDHE-PSK-AES128-CBC-SHA"},
+	{"TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA", "ECDHE-PSK-AES256-CBC-SHA"},
+	{"TLS_ECDHE_PSK_WITH_AES_128_CBC_SHA256", "ECDHE-PSK-AES128-CBC-SHA256"},
+	{"TLS_ECDHE_PSK_WITH_AES_256_CBC_SHA384", "ECDHE-PSK-AES256-CBC-SHA384"},
+	{"TLS_ECDHE_PSK_WITH_NULL_SHA", "ECDHE-PSK-NULL-SHA"},
+	{"TLS_ECDHE_PSK_WITH_NULL_SHA256", "ECDHE-PSK-NULL-SHA256"},
+	{"TLS_ECDHE_PSK_WITH_NULL_SHA384", "ECDHE-PSK-NULL-SHA384"},
+	{"TLS_RSA_WITH_ARIA_128_GCM_SHA256", "ARIA128-GCM-SHA256"},
+	{"TLS_RSA_WITH_ARIA_256_GCM_SHA384", "ARIA256-GCM-SHA384"},
+	{"TLS_DHE_RSA_WITH_ARIA_128_GCM_SHA256", "DHE-RSA-ARIA128-GCM-SHA256"},
+	{"TLS_DHE_RSA_WITH_ARIA_256_GCM_SHA384", "DHE-RSA-ARIA256-GCM-SHA384"},
+	{"TLS_DHE_DSS_WITH_ARIA_128_GCM_SHA256", "DHE-DSS-ARIA128-GCM-SHA256"},
+	{"TLS_DHE_DSS_WITH_ARIA_256_GCM_SHA384", "DHE-DSS-ARIA256-GCM-SHA384"},
+	{"TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256", "ECDHE-ECDSA-ARIA128-GCM-SHA256"},
+	{"TLS_ECDHE_ECDSA_WITH_ARIA_256_GCM_SHA384", "ECDHE-ECDSA-ARIA256-GCM-SHA384"},
+	{"TLS_ECDHE_RSA_WITH_ARIA_128_GCM_SHA256", "ECDHE-ARIA128-GCM-SHA256"},
+	{"TLS_ECDHE_RSA_WITH_ARIA_256_GCM_SHA384", "ECDHE-ARIA256-GCM-SHA384"},
+	{"TLS_PSK_WITH_ARIA_128_GCM_SHA256", "PSK-ARIA128-GCM-SHA256"},
+	{"TLS_PSK_WITH_ARIA_256_GCM_SHA384", "PSK-ARIA256-GCM-SHA384"},
+	{"TLS_DHE_PSK_WITH_ARIA_128_GCM_SHA256", "DHE-PSK-ARIA128-GCM-SHA256"},
+	{"TLS_DHE_PSK_WITH_ARIA_256_GCM_SHA384", "DHE-PSK-ARIA256-GCM-SHA384"},
+	{"TLS_RSA_PSK_WITH_ARIA_128_GCM_SHA256", "RSA-PSK-ARIA128-GCM-SHA256"},
+	{"TLS_RSA_PSK_WITH_ARIA_256_GCM_SHA384", "RSA-PSK-ARIA256-GCM-SHA384"},
+	{"TLS_ECDHE_ECDSA_WITH_CAMELLIA_128_CBC_SHA256", "ECDHE-ECDSA-CAMELLIA128-SHA256"},
+	{"TLS_ECDHE_ECDSA_WITH_CAMELLIA_256_CBC_SHA384", "ECDHE-ECDSA-CAMELLIA256-SHA384"},
+	{"TLS_ECDHE_RSA_WITH_CAMELLIA_128_CBC_SHA256", "ECDHE-RSA-CAMELLIA128-SHA256"},
+	{"TLS_ECDHE_RSA_WITH_CAMELLIA_256_CBC_SHA384", "ECDHE-RSA-CAMELLIA256-SHA384"},
+	{"TLS_PSK_WITH_CAMELLIA_128_CBC_SHA256", "PSK-CAMELLIA128-SHA256"},
+	{"TLS_PSK_WITH_CAMELLIA_256_CBC_SHA384", "PSK-CAMELLIA256-SHA384"},
+	{"TLS_DHE_PSK_WITH_CAMELLIA_128_CBC_SHA256", "DHE-PSK-CAMELLIA128-SHA256"},
+	{"TLS_DHE_PSK_WITH_CAMELLIA_256_CBC_SHA384", "DHE-PSK-CAMELLIA256-SHA384"},
+	{"TLS_RSA_PSK_WITH_CAMELLIA_128_CBC_SHA256", "RSA-PSK-CAMELLIA128-SHA256"},
+	{"TLS_RSA_PSK_WITH_CAMELLIA_256_CBC_SHA384", "RSA-PSK-CAMELLIA256-SHA384"},
+	{"TLS_ECDHE_PSK_WITH_CAMELLIA_128_CBC_SHA256", "ECDHE-PSK-CAMELLIA128-SHA256"},
+	{"TLS_ECDHE_PSK_WITH_CAMELLIA_256_CBC_SHA384", "ECDHE-PSK-CAMELLIA256-SHA384"},
+	{"TLS_RSA_WITH_AES_128_CCM", "AES128-CCM"},
+	{"TLS_RSA_WITH_AES_256_CCM", "AES256-CCM"},
+	{"TLS_DHE_RSA_WITH_AES_128_CCM", "DHE-RSA-AES128-CCM"},
+	{"TLS_DHE_RSA_WITH_AES_256_CCM", "DHE-RSA-AES256-CCM"},
+	{"TLS_RSA_WITH_AES_128_CCM_8", "AES128-CCM8"},
+	{"TLS_RSA_WITH_AES_256_CCM_8", "AES256-CCM8"},
+	{"TLS_DHE_RSA_WITH_AES_128_CCM_8", "DHE-RSA-AES128-CCM8"},
+	{"TLS_DHE_RSA_WITH_AES_256_CCM_8", "DHE-RSA-AES256-CCM8"},
+	{"TLS_PSK_WITH_AES_128_CCM", "PSK-AES128-CCM"},
+	{"TLS_PSK_WITH_AES_256_CCM", "PSK-AES256-CCM"},
+	{"TLS_DHE_PSK_WITH_AES_128_CCM", "DHE-PSK-AES128-CCM"},
+	{"TLS_DHE_PSK_WITH_AES_256_CCM", "DHE-PSK-AES256-CCM"},
+	{"TLS_PSK_WITH_AES_128_CCM_8", "PSK-AES128-CCM8"},
+	{"TLS_PSK_WITH_AES_256_CCM_8", "PSK-AES256-CCM8"},
+	{"TLS_PSK_DHE_WITH_AES_128_CCM_8", "DHE-PSK-AES128-CCM8"},
+	{"TLS_PSK_DHE_WITH_AES_256_CCM_8", "DHE-PSK-AES256-CCM8"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_128_CCM", "ECDHE-ECDSA-AES128-CCM"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_256_CCM", "ECDHE-ECDSA-AES256-CCM"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_128_CCM_8", "ECDHE-ECDSA-AES128-CCM8"},
+	{"TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8", "ECDHE-ECDSA-AES256-CCM8"},
+	{"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256", "ECDHE-RSA-CHACHA20-POLY1305"},
+	{"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256", "ECDHE-ECDSA-CHACHA20-POLY1305"},
+	{"TLS_DHE_RSA_WITH_CHACHA20_POLY1305_SHA256", "DHE-RSA-CHACHA20-POLY1305"},
+	{"TLS_PSK_WITH_CHACHA20_POLY1305_SHA256", "PSK-CHACHA20-POLY1305"},
+	{"TLS_ECDHE_PSK_WITH_CHACHA20_POLY1305_SHA256", "ECDHE-PSK-CHACHA20-POLY1305"},
+	{"TLS_DHE_PSK_WITH_CHACHA20_POLY1305_SHA256", "DHE-PSK-CHACHA20-POLY1305"},
+	{"TLS_RSA_PSK_WITH_CHACHA20_POLY1305_SHA256", "RSA-PSK-CHACHA20-POLY1305"},
+}
+
+var (
+	namesByIANA    = make(map[string]cipherSuiteName, len(cipherSuiteNames))
+	namesByOpenSSL = make(map[string]cipherSuiteName, len(cipherSuiteNames))
+)
+
+func init() {
+	for _, n := range cipherSuiteNames {
+		namesByIANA[n.name] = n
+		namesByOpenSSL[n.openssl] = n
+	}
+}
+
+// OpenSSLName returns the name OpenSSL uses for the named cipher suite, such
+// as ECDHE-RSA-AES128-GCM-SHA256 for
+// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+func OpenSSLName(name string) (string, bool) {
+	n, ok := namesByIANA[name]
+	return n.openssl, ok
+}
+
+// FromOpenSSLName returns the IANA name of the cipher suite OpenSSL calls
+// name.
+func FromOpenSSLName(name string) (string, bool) {
+	n, ok := namesByOpenSSL[name]
+	return n.name, ok
+}
+
+// ParseCipherSuite resolves s to the IANA name of a cipher suite. s may be an
+// IANA name, an OpenSSL name, or an identifier written in hexadecimal either
+// as a single number, such as 0xC02F, or as two bytes, such as 0xC0,0x2F.
+func ParseCipherSuite(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		id, err := parseID(s)
+		if err != nil {
+			return "", err
+		}
+		name, ok := CipherSuiteName(id)
+		if !ok {
+			return "", fmt.Errorf("unknown cipher suite identifier 0x%04X", id)
+		}
+		return name, nil
+	}
+
+	if name, ok := FromOpenSSLName(s); ok {
+		return name, nil
+	}
+	if _, ok := GetCipherSuite(s); ok {
+		return s, nil
+	}
+	return "", fmt.Errorf("unknown cipher suite %q", s)
+}
+
+func parseID(s string) (uint16, error) {
+	digits := s
+	if i := strings.Index(s, ","); i >= 0 {
+		hi, lo := strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:])
+		if len(hi) != 4 || len(lo) != 4 || !strings.HasPrefix(strings.ToLower(lo), "0x") {
+			return 0, fmt.Errorf("invalid cipher suite identifier %q", s)
+		}
+		digits = hi + lo[2:]
+	}
+
+	id, err := strconv.ParseUint(digits[2:], 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cipher suite identifier %q", s)
+	}
+	return uint16(id), nil
+}
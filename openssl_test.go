@@ -0,0 +1,101 @@
+package ciphersuites_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestParseCipherSuite(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		"resolves IANA name": {
+			input: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+			want:  "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		},
+		"resolves OpenSSL name": {
+			input: "ECDHE-RSA-AES128-GCM-SHA256",
+			want:  "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		},
+		"resolves identifier": {
+			input: "0xC02F",
+			want:  "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		},
+		"resolves registry identifier": {
+			input: "0xC0,0x2F",
+			want:  "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		},
+		"resolves identifier unknown to crypto/tls": {
+			input: "0x0067",
+			want:  "TLS_DHE_RSA_WITH_AES_128_CBC_SHA256",
+		},
+		"resolves identifier unknown to OpenSSL": {
+			input: "0x0016",
+			want:  "TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA",
+		},
+		"rejects unknown name": {
+			input:   "TLS_UNKNOWN",
+			wantErr: true,
+		},
+		"rejects unknown identifier": {
+			input:   "0xFFFF",
+			wantErr: true,
+		},
+		"rejects malformed identifier": {
+			input:   "0xC0,2F",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ciphersuites.ParseCipherSuite(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSSLName(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		name string
+		want string
+	}{
+		"TLS 1.2 cipher suite": {
+			name: "TLS_RSA_WITH_AES_128_CBC_SHA",
+			want: "AES128-SHA",
+		},
+		"TLS 1.3 cipher suite": {
+			name: "TLS_AES_128_GCM_SHA256",
+			want: "TLS_AES_128_GCM_SHA256",
+		},
+		"unknown cipher suite": {
+			name: "TLS_UNKNOWN",
+			want: "",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := ciphersuites.OpenSSLName(tt.name)
+			if got != tt.want {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", got, tt.want)
+			}
+		})
+	}
+}
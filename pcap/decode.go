@@ -0,0 +1,173 @@
+package pcap
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86DD
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88A8
+
+	protocolTCP = 6
+
+	tcpFlagSYN = 0x02
+	tcpFlagACK = 0x10
+)
+
+// segment is a decoded TCP segment.
+type segment struct {
+	src, dst net.TCPAddr
+	seq      uint32
+	flags    uint8
+	payload  []byte
+}
+
+// decodeTCP decodes the link, network and transport headers of a packet,
+// reporting false if it does not carry a TCP segment.
+func decodeTCP(p Packet) (segment, bool) {
+	ipPacket, etherType, ok := decodeLink(p.LinkType, p.Data)
+	if !ok {
+		return segment{}, false
+	}
+
+	var (
+		src, dst net.IP
+		tcp      []byte
+	)
+	switch etherType {
+	case etherTypeIPv4:
+		src, dst, tcp, ok = decodeIPv4(ipPacket)
+	case etherTypeIPv6:
+		src, dst, tcp, ok = decodeIPv6(ipPacket)
+	default:
+		return segment{}, false
+	}
+	if !ok || len(tcp) < 20 {
+		return segment{}, false
+	}
+
+	offset := int(tcp[12]>>4) * 4
+	if offset < 20 || offset > len(tcp) {
+		return segment{}, false
+	}
+
+	return segment{
+		src:     net.TCPAddr{IP: src, Port: int(binary.BigEndian.Uint16(tcp[0:2]))},
+		dst:     net.TCPAddr{IP: dst, Port: int(binary.BigEndian.Uint16(tcp[2:4]))},
+		seq:     binary.BigEndian.Uint32(tcp[4:8]),
+		flags:   tcp[13],
+		payload: tcp[offset:],
+	}, true
+}
+
+// decodeLink strips the link-layer header, returning the network-layer packet
+// and its EtherType.
+func decodeLink(linkType LinkType, data []byte) ([]byte, uint16, bool) {
+	switch linkType {
+	case LinkTypeEthernet:
+		if len(data) < 14 {
+			return nil, 0, false
+		}
+		etherType := binary.BigEndian.Uint16(data[12:14])
+		data = data[14:]
+		for etherType == etherTypeVLAN || etherType == etherTypeQinQ {
+			if len(data) < 4 {
+				return nil, 0, false
+			}
+			etherType = binary.BigEndian.Uint16(data[2:4])
+			data = data[4:]
+		}
+		return data, etherType, true
+	case LinkTypeNull, LinkTypeLoop:
+		// The address family is in host byte order for DLT_NULL and network
+		// byte order for DLT_LOOP, so infer the version from the IP header.
+		if len(data) < 5 {
+			return nil, 0, false
+		}
+		return ipVersion(data[4:])
+	case LinkTypeRaw:
+		return ipVersion(data)
+	case LinkTypeLinuxSLL:
+		if len(data) < 16 {
+			return nil, 0, false
+		}
+		return data[16:], binary.BigEndian.Uint16(data[14:16]), true
+	default:
+		return nil, 0, false
+	}
+}
+
+func ipVersion(data []byte) ([]byte, uint16, bool) {
+	if len(data) == 0 {
+		return nil, 0, false
+	}
+	switch data[0] >> 4 {
+	case 4:
+		return data, etherTypeIPv4, true
+	case 6:
+		return data, etherTypeIPv6, true
+	default:
+		return nil, 0, false
+	}
+}
+
+func decodeIPv4(data []byte) (net.IP, net.IP, []byte, bool) {
+	if len(data) < 20 || data[0]>>4 != 4 {
+		return nil, nil, nil, false
+	}
+
+	headerLen := int(data[0]&0x0F) * 4
+	totalLen := int(binary.BigEndian.Uint16(data[2:4]))
+	if headerLen < 20 || totalLen < headerLen || len(data) < headerLen {
+		return nil, nil, nil, false
+	}
+	if totalLen < len(data) {
+		// Discard Ethernet padding.
+		data = data[:totalLen]
+	}
+
+	// Fragmented packets are not reassembled. Only the first fragment carries
+	// the TCP header, and handshakes are rarely fragmented at the IP layer.
+	if binary.BigEndian.Uint16(data[6:8])&0x1FFF != 0 || data[9] != protocolTCP {
+		return nil, nil, nil, false
+	}
+
+	return net.IP(data[12:16]), net.IP(data[16:20]), data[headerLen:], true
+}
+
+func decodeIPv6(data []byte) (net.IP, net.IP, []byte, bool) {
+	if len(data) < 40 || data[0]>>4 != 6 {
+		return nil, nil, nil, false
+	}
+
+	payloadLen := int(binary.BigEndian.Uint16(data[4:6]))
+	next := data[6]
+	src, dst := net.IP(data[8:24]), net.IP(data[24:40])
+	payload := data[40:]
+	if payloadLen < len(payload) {
+		payload = payload[:payloadLen]
+	}
+
+	// Skip extension headers until the TCP header is reached.
+	for next != protocolTCP {
+		switch next {
+		case 0, 43, 60: // Hop-by-hop, routing and destination options.
+			if len(payload) < 8 {
+				return nil, nil, nil, false
+			}
+			length := (int(payload[1]) + 1) * 8
+			if len(payload) < length {
+				return nil, nil, nil, false
+			}
+			next = payload[0]
+			payload = payload[length:]
+		default:
+			return nil, nil, nil, false
+		}
+	}
+
+	return src, dst, payload, true
+}
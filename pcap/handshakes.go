@@ -0,0 +1,277 @@
+package pcap
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/handshake"
+)
+
+// maxStreamBytes bounds how much of each direction of a connection is
+// buffered. The hello messages are always at the start of a stream, so
+// anything beyond this is never needed.
+const maxStreamBytes = 64 << 10
+
+// Handshake describes the TLS handshake observed on a single TCP connection.
+type Handshake struct {
+	Client net.TCPAddr
+	Server net.TCPAddr
+
+	// Start is the timestamp of the first packet seen on the connection.
+	Start time.Time
+
+	// ClientHello is nil if the client's hello was not captured.
+	ClientHello *handshake.ClientHello
+
+	// ServerHello is nil if the server's hello was not captured, such as when
+	// the handshake failed or the capture ended early.
+	ServerHello *handshake.ServerHello
+}
+
+// Complete reports whether the server's choice of cipher suite was captured.
+func (h Handshake) Complete() bool {
+	return h.ServerHello != nil
+}
+
+// Version returns the negotiated protocol version, or zero if the handshake is
+// incomplete.
+func (h Handshake) Version() uint16 {
+	if h.ServerHello == nil {
+		return 0
+	}
+	return h.ServerHello.NegotiatedVersion()
+}
+
+// CipherSuite returns the negotiated cipher suite, or zero if the handshake is
+// incomplete.
+func (h Handshake) CipherSuite() uint16 {
+	if h.ServerHello == nil {
+		return 0
+	}
+	return h.ServerHello.CipherSuite
+}
+
+// ReadHandshakes reads a pcap or pcapng capture and returns the TLS handshakes
+// of every TCP connection in it, in the order the connections were first
+// seen. Connections that do not carry TLS are omitted.
+func ReadHandshakes(r io.Reader) ([]Handshake, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	a := newAssembler()
+	for {
+		packet, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read packet: %w", err)
+		}
+		if seg, ok := decodeTCP(packet); ok {
+			a.add(packet.Timestamp, seg)
+		}
+	}
+
+	var handshakes []Handshake
+	for _, conn := range a.connections() {
+		if h, ok := conn.handshake(); ok {
+			handshakes = append(handshakes, h)
+		}
+	}
+
+	return handshakes, nil
+}
+
+// assembler groups TCP segments into connections.
+type assembler struct {
+	active   map[string]*connection
+	finished []*connection
+}
+
+func newAssembler() *assembler {
+	return &assembler{active: make(map[string]*connection)}
+}
+
+func (a *assembler) add(ts time.Time, seg segment) {
+	key := flowKey(seg.src, seg.dst)
+	conn, ok := a.active[key]
+
+	// A new SYN on a known flow is a reused port, so start a new connection.
+	if ok && seg.flags&tcpFlagSYN != 0 && seg.flags&tcpFlagACK == 0 {
+		if half := conn.halves[seg.src.String()]; half != nil && (!half.synSeen || half.isn != seg.seq) {
+			a.finished = append(a.finished, conn)
+			ok = false
+		}
+	}
+
+	if !ok {
+		conn = &connection{
+			start:  ts,
+			halves: make(map[string]*halfStream),
+		}
+		a.active[key] = conn
+	}
+
+	conn.add(seg)
+}
+
+// connections returns every connection ordered by the time it was first seen.
+func (a *assembler) connections() []*connection {
+	conns := append([]*connection(nil), a.finished...)
+	for _, conn := range a.active {
+		conns = append(conns, conn)
+	}
+	sort.SliceStable(conns, func(i, j int) bool {
+		return conns[i].start.Before(conns[j].start)
+	})
+	return conns
+}
+
+func flowKey(a, b net.TCPAddr) string {
+	x, y := a.String(), b.String()
+	if x > y {
+		x, y = y, x
+	}
+	return x + "|" + y
+}
+
+// connection holds both directions of a TCP connection.
+type connection struct {
+	start  time.Time
+	client string
+	halves map[string]*halfStream
+	order  []string
+}
+
+func (c *connection) add(seg segment) {
+	src := seg.src.String()
+	half, ok := c.halves[src]
+	if !ok {
+		half = &halfStream{src: seg.src, dst: seg.dst}
+		c.halves[src] = half
+		c.order = append(c.order, src)
+	}
+
+	if seg.flags&tcpFlagSYN != 0 {
+		if seg.flags&tcpFlagACK == 0 {
+			c.client = src
+		}
+		half.synSeen = true
+		half.isn = seg.seq
+	}
+	half.add(seg.seq, seg.payload)
+}
+
+func (c *connection) handshake() (Handshake, bool) {
+	h := Handshake{Start: c.start}
+	for _, src := range c.order {
+		half := c.halves[src]
+		messages, err := handshake.ReadMessages(half.assemble())
+		if err != nil {
+			continue
+		}
+
+		for _, m := range messages {
+			switch m.Type() {
+			case handshake.TypeClientHello:
+				if hello, err := handshake.ParseClientHello(m); err == nil && h.ClientHello == nil {
+					h.ClientHello = hello
+					h.Client, h.Server = half.src, half.dst
+				}
+			case handshake.TypeServerHello:
+				if hello, err := handshake.ParseServerHello(m); err == nil && !hello.IsHelloRetryRequest() {
+					h.ServerHello = hello
+					if h.ClientHello == nil {
+						h.Client, h.Server = half.dst, half.src
+					}
+				}
+			}
+		}
+	}
+
+	if h.ClientHello == nil && h.ServerHello == nil {
+		return Handshake{}, false
+	}
+
+	// Without a captured hello from the client, fall back to the TCP
+	// handshake to identify which side initiated the connection.
+	if h.ClientHello == nil && c.client != "" {
+		half := c.halves[c.client]
+		h.Client, h.Server = half.src, half.dst
+	}
+
+	return h, true
+}
+
+// halfStream collects the payload sent in one direction of a connection.
+type halfStream struct {
+	src, dst net.TCPAddr
+	synSeen  bool
+	isn      uint32
+	segments []payload
+	size     int
+}
+
+type payload struct {
+	seq  uint32
+	data []byte
+}
+
+func (s *halfStream) add(seq uint32, data []byte) {
+	if len(data) == 0 || s.size >= maxStreamBytes {
+		return
+	}
+	s.segments = append(s.segments, payload{seq: seq, data: append([]byte(nil), data...)})
+	s.size += len(data)
+}
+
+// assemble returns the contiguous bytes at the start of the stream, stopping
+// at the first gap. Retransmitted and overlapping segments are tolerated.
+func (s *halfStream) assemble() []byte {
+	if len(s.segments) == 0 {
+		return nil
+	}
+
+	// Sequence numbers wrap, so offsets are computed relative to a base using
+	// signed arithmetic.
+	base := s.isn + 1
+	if !s.synSeen {
+		base = s.segments[0].seq
+		for _, seg := range s.segments[1:] {
+			if int32(seg.seq-base) < 0 {
+				base = seg.seq
+			}
+		}
+	}
+
+	type span struct {
+		offset int
+		data   []byte
+	}
+	spans := make([]span, 0, len(s.segments))
+	for _, seg := range s.segments {
+		spans = append(spans, span{offset: int(int32(seg.seq - base)), data: seg.data})
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].offset < spans[j].offset
+	})
+
+	var stream []byte
+	for _, sp := range spans {
+		end := sp.offset + len(sp.data)
+		if sp.offset > len(stream) {
+			break
+		}
+		if end <= len(stream) {
+			continue
+		}
+		stream = append(stream, sp.data[len(stream)-sp.offset:]...)
+	}
+
+	return stream
+}
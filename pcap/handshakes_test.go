@@ -0,0 +1,93 @@
+package pcap_test
+
+import (
+	"crypto/tls"
+	"os"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/pcap"
+)
+
+func TestReadHandshakes(t *testing.T) {
+	t.Parallel()
+
+	type connection struct {
+		client      string
+		server      string
+		serverName  string
+		version     uint16
+		cipherSuite uint16
+	}
+
+	var tests = map[string]struct {
+		file string
+		want []connection
+	}{
+		"reads pcap": {
+			file: "testdata/handshakes.pcap",
+			want: []connection{
+				{"192.0.2.10:50001", "198.51.100.20:443", "example.test", tls.VersionTLS13, tls.TLS_AES_128_GCM_SHA256},
+				{"192.0.2.10:50002", "198.51.100.20:8443", "legacy.example.test", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+				{"192.0.2.10:50003", "198.51.100.20:443", "", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA},
+				{"192.0.2.10:50005", "198.51.100.20:443", "partial.example.test", 0, 0},
+			},
+		},
+		"reads pcapng": {
+			file: "testdata/handshakes.pcapng",
+			want: []connection{
+				{"[2001:db8::10]:50001", "[2001:db8::20]:443", "example.test", tls.VersionTLS13, tls.TLS_AES_128_GCM_SHA256},
+				{"[2001:db8::10]:50002", "[2001:db8::20]:443", "legacy.example.test", tls.VersionTLS12, tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA},
+			},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := os.Open(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			handshakes, err := pcap.ReadHandshakes(f)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(handshakes) != len(tt.want) {
+				t.Fatalf("mismatch:\n  got:  %d handshakes\n  want: %d handshakes", len(handshakes), len(tt.want))
+			}
+
+			for i, h := range handshakes {
+				got := connection{
+					client:      h.Client.String(),
+					server:      h.Server.String(),
+					version:     h.Version(),
+					cipherSuite: h.CipherSuite(),
+				}
+				if h.ClientHello != nil {
+					got.serverName = h.ClientHello.ServerName
+				}
+				if got != tt.want[i] {
+					t.Errorf("mismatch at %d:\n  got:  %+v\n  want: %+v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReadHandshakesRejectsUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open("handshakes_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := pcap.ReadHandshakes(f); err != pcap.ErrUnknownFormat {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, pcap.ErrUnknownFormat)
+	}
+}
@@ -0,0 +1,319 @@
+// Package pcap reads TLS handshakes from packet captures.
+//
+// Both the classic libpcap format and the pcapng format are supported. TCP
+// streams are reassembled only far enough to recover the ClientHello and
+// ServerHello messages of each connection, which is all that is required to
+// determine the cipher suite a connection negotiated.
+package pcap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// LinkType identifies the link-layer header type of captured packets.
+type LinkType uint16
+
+// Supported link-layer header types.
+const (
+	LinkTypeNull     LinkType = 0
+	LinkTypeEthernet LinkType = 1
+	LinkTypeRaw      LinkType = 101
+	LinkTypeLoop     LinkType = 108
+	LinkTypeLinuxSLL LinkType = 113
+)
+
+const (
+	pcapMagicMicroseconds = 0xA1B2C3D4
+	pcapMagicNanoseconds  = 0xA1B23C4D
+
+	pcapngSectionHeader        = 0x0A0D0D0A
+	pcapngInterfaceDescription = 0x00000001
+	pcapngSimplePacket         = 0x00000003
+	pcapngEnhancedPacket       = 0x00000006
+	pcapngByteOrderMagic       = 0x1A2B3C4D
+
+	// maxBlockSize bounds the memory allocated for a single record so that a
+	// corrupt length field cannot exhaust memory.
+	maxBlockSize = 16 << 20
+)
+
+// ErrUnknownFormat is returned when a file is neither a pcap nor a pcapng
+// capture.
+var ErrUnknownFormat = errors.New("pcap: unknown capture file format")
+
+// Packet is a single captured frame.
+type Packet struct {
+	Timestamp time.Time
+	LinkType  LinkType
+	Data      []byte
+}
+
+// Reader reads packets from a pcap or pcapng capture.
+type Reader struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+	ng    bool
+
+	// pcap state.
+	linkType   LinkType
+	resolution time.Duration
+
+	// pcapng state.
+	interfaces []iface
+}
+
+type iface struct {
+	linkType   LinkType
+	snapLen    uint32
+	resolution time.Duration
+	binary     bool
+}
+
+// NewReader creates a new capture reader, detecting the file format from its
+// leading magic number.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read magic number: %w", err)
+	}
+
+	reader := &Reader{r: br}
+	if binary.BigEndian.Uint32(magic) == pcapngSectionHeader {
+		reader.ng = true
+		return reader, nil
+	}
+
+	switch {
+	case binary.LittleEndian.Uint32(magic) == pcapMagicMicroseconds:
+		reader.order, reader.resolution = binary.LittleEndian, time.Microsecond
+	case binary.BigEndian.Uint32(magic) == pcapMagicMicroseconds:
+		reader.order, reader.resolution = binary.BigEndian, time.Microsecond
+	case binary.LittleEndian.Uint32(magic) == pcapMagicNanoseconds:
+		reader.order, reader.resolution = binary.LittleEndian, time.Nanosecond
+	case binary.BigEndian.Uint32(magic) == pcapMagicNanoseconds:
+		reader.order, reader.resolution = binary.BigEndian, time.Nanosecond
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	header := make([]byte, 24)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("failed to read file header: %w", err)
+	}
+	reader.linkType = LinkType(reader.order.Uint32(header[20:24]))
+
+	return reader, nil
+}
+
+// Next returns the next packet in the capture. It returns [io.EOF] when there
+// are no more packets.
+func (r *Reader) Next() (Packet, error) {
+	if r.ng {
+		return r.nextBlock()
+	}
+	return r.nextRecord()
+}
+
+func (r *Reader) nextRecord() (Packet, error) {
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(r.r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return Packet{}, fmt.Errorf("truncated record header: %w", err)
+		}
+		return Packet{}, err
+	}
+
+	seconds := r.order.Uint32(header[0:4])
+	fraction := r.order.Uint32(header[4:8])
+	length := r.order.Uint32(header[8:12])
+	if length > maxBlockSize {
+		return Packet{}, fmt.Errorf("record length %d exceeds limit", length)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return Packet{}, fmt.Errorf("truncated record: %w", err)
+	}
+
+	return Packet{
+		Timestamp: time.Unix(int64(seconds), int64(fraction)*int64(r.resolution)).UTC(),
+		LinkType:  r.linkType,
+		Data:      data,
+	}, nil
+}
+
+func (r *Reader) nextBlock() (Packet, error) {
+	for {
+		typ, body, err := r.readBlock()
+		if err != nil {
+			return Packet{}, err
+		}
+
+		switch typ {
+		case pcapngSectionHeader:
+			// Interface identifiers are scoped to a section.
+			r.interfaces = nil
+		case pcapngInterfaceDescription:
+			if err := r.addInterface(body); err != nil {
+				return Packet{}, err
+			}
+		case pcapngEnhancedPacket:
+			return r.enhancedPacket(body)
+		case pcapngSimplePacket:
+			return r.simplePacket(body)
+		}
+	}
+}
+
+// readBlock reads a pcapng block, returning its type and body. Section header
+// blocks also establish the byte order used by the blocks that follow.
+func (r *Reader) readBlock() (uint32, []byte, error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(r.r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, fmt.Errorf("truncated block header: %w", err)
+		}
+		return 0, nil, err
+	}
+
+	if binary.BigEndian.Uint32(header[0:4]) == pcapngSectionHeader {
+		magic, err := r.r.Peek(4)
+		if err != nil {
+			return 0, nil, fmt.Errorf("truncated section header: %w", err)
+		}
+		switch {
+		case binary.LittleEndian.Uint32(magic) == pcapngByteOrderMagic:
+			r.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(magic) == pcapngByteOrderMagic:
+			r.order = binary.BigEndian
+		default:
+			return 0, nil, ErrUnknownFormat
+		}
+	}
+	if r.order == nil {
+		return 0, nil, ErrUnknownFormat
+	}
+
+	typ := r.order.Uint32(header[0:4])
+	length := r.order.Uint32(header[4:8])
+	if length < 12 || length%4 != 0 || length > maxBlockSize {
+		return 0, nil, fmt.Errorf("invalid block length %d", length)
+	}
+
+	// The block is followed by a trailing copy of its length.
+	body := make([]byte, length-8)
+	if _, err := io.ReadFull(r.r, body); err != nil {
+		return 0, nil, fmt.Errorf("truncated block: %w", err)
+	}
+
+	return typ, body[:len(body)-4], nil
+}
+
+func (r *Reader) addInterface(body []byte) error {
+	if len(body) < 8 {
+		return errors.New("truncated interface description block")
+	}
+
+	ifc := iface{
+		linkType:   LinkType(r.order.Uint16(body[0:2])),
+		snapLen:    r.order.Uint32(body[4:8]),
+		resolution: time.Microsecond,
+	}
+
+	// Walk the options looking for if_tsresol.
+	options := body[8:]
+	for len(options) >= 4 {
+		code := r.order.Uint16(options[0:2])
+		length := int(r.order.Uint16(options[2:4]))
+		padded := (length + 3) &^ 3
+		if len(options) < 4+padded {
+			break
+		}
+		if code == 0 {
+			break
+		}
+		if code == 9 && length == 1 {
+			ifc.resolution, ifc.binary = tsResolution(options[4])
+		}
+		options = options[4+padded:]
+	}
+
+	r.interfaces = append(r.interfaces, ifc)
+	return nil
+}
+
+// tsResolution decodes the if_tsresol option. When the most significant bit
+// is set the remaining bits are a negative power of two, otherwise a negative
+// power of ten.
+func tsResolution(value byte) (time.Duration, bool) {
+	if value&0x80 != 0 {
+		return 0, true
+	}
+	resolution := time.Second
+	for i := byte(0); i < value && resolution > 1; i++ {
+		resolution /= 10
+	}
+	return resolution, false
+}
+
+func (r *Reader) enhancedPacket(body []byte) (Packet, error) {
+	if len(body) < 20 {
+		return Packet{}, errors.New("truncated enhanced packet block")
+	}
+
+	id := r.order.Uint32(body[0:4])
+	if int(id) >= len(r.interfaces) {
+		return Packet{}, fmt.Errorf("packet references unknown interface %d", id)
+	}
+	ifc := r.interfaces[id]
+
+	ts := uint64(r.order.Uint32(body[4:8]))<<32 | uint64(r.order.Uint32(body[8:12]))
+	length := r.order.Uint32(body[12:16])
+	if int(length) > len(body)-20 {
+		return Packet{}, errors.New("enhanced packet block data exceeds block length")
+	}
+
+	return Packet{
+		Timestamp: ifc.timestamp(ts),
+		LinkType:  ifc.linkType,
+		Data:      body[20 : 20+length],
+	}, nil
+}
+
+func (r *Reader) simplePacket(body []byte) (Packet, error) {
+	if len(body) < 4 {
+		return Packet{}, errors.New("truncated simple packet block")
+	}
+	if len(r.interfaces) == 0 {
+		return Packet{}, errors.New("simple packet block without interface")
+	}
+	ifc := r.interfaces[0]
+
+	length := r.order.Uint32(body[0:4])
+	if ifc.snapLen != 0 && length > ifc.snapLen {
+		length = ifc.snapLen
+	}
+	if int(length) > len(body)-4 {
+		length = uint32(len(body) - 4)
+	}
+
+	return Packet{
+		LinkType: ifc.linkType,
+		Data:     body[4 : 4+length],
+	}, nil
+}
+
+func (i iface) timestamp(ts uint64) time.Time {
+	if i.binary {
+		// Binary resolutions are rare in practice, so they are not converted.
+		return time.Time{}
+	}
+	units := uint64(time.Second / i.resolution)
+	return time.Unix(int64(ts/units), int64(ts%units)*int64(i.resolution)).UTC()
+}
@@ -0,0 +1,355 @@
+// Package policy applies cipher suite classification policies to individual
+// TLS connections.
+//
+// An [Enforcer] provides [tls.Config.GetConfigForClient] and
+// [tls.Config.VerifyConnection] callbacks. During the handshake it selects a
+// [Policy] from the server name requested by the client, restricts the server
+// to cipher suites that satisfy the policy, and refuses clients that offer
+// nothing acceptable. Once the handshake completes it checks the negotiated
+// cipher suite again, applying a stricter policy selected from the client
+// certificate if there is one.
+package policy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// Policy defines the cipher suites acceptable for a group of connections.
+type Policy struct {
+	// Name identifies the policy in events.
+	Name string
+
+	// MinClassification is the weakest classification a connection should
+	// negotiate.
+	MinClassification ciphersuites.Classification
+
+	// Downgrade is the weakest classification accepted from clients that offer
+	// no cipher suite meeting MinClassification. Such connections are allowed
+	// with a [Downgraded] event rather than refused. Downgrades are disabled
+	// when Downgrade is [ciphersuites.Unknown].
+	Downgrade ciphersuites.Classification
+}
+
+// Config configures an [Enforcer].
+type Config struct {
+	// Base is the configuration cloned for every connection. Its cipher
+	// suites are replaced according to the selected policy.
+	Base *tls.Config
+
+	// Default is the policy applied when no other policy matches.
+	Default Policy
+
+	// ServerNames maps server names requested through SNI to policies.
+	ServerNames map[string]Policy
+
+	// ClientCertificate, if set, selects a policy from the verified client
+	// certificate once the handshake has completed.
+	ClientCertificate func(cert *x509.Certificate) (Policy, bool)
+
+	// OnEvent, if set, is called with every policy decision.
+	OnEvent func(Event)
+}
+
+// Action is the outcome of a policy decision.
+type Action string
+
+// Policy decision outcomes.
+const (
+	Allowed    Action = "allow"
+	Downgraded Action = "downgrade"
+	Refused    Action = "refuse"
+)
+
+// Stage identifies the point in the handshake a decision was made.
+type Stage string
+
+// Handshake stages.
+const (
+	StageClientHello      Stage = "client_hello"
+	StageVerifyConnection Stage = "verify_connection"
+)
+
+// Event records a single policy decision.
+type Event struct {
+	Stage      Stage
+	Action     Action
+	Policy     string
+	ServerName string
+
+	// RemoteAddr is only known at [StageClientHello].
+	RemoteAddr string
+
+	// Offered lists the cipher suites offered by the client at
+	// [StageClientHello].
+	Offered []uint16
+
+	// CipherSuite and Version are the negotiated parameters at
+	// [StageVerifyConnection].
+	CipherSuite uint16
+	Version     uint16
+
+	// Classification is the strongest classification offered by the client,
+	// or the classification of the negotiated cipher suite at the negotiated
+	// version.
+	Classification ciphersuites.Classification
+
+	Reason string
+}
+
+// LogValue implements [slog.LogValuer] so that events can be logged as
+// structured records.
+func (e Event) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("stage", string(e.Stage)),
+		slog.String("action", string(e.Action)),
+		slog.String("policy", e.Policy),
+		slog.String("server_name", e.ServerName),
+		slog.String("classification", e.Classification.String()),
+	}
+	if e.RemoteAddr != "" {
+		attrs = append(attrs, slog.String("remote_addr", e.RemoteAddr))
+	}
+	if e.CipherSuite != 0 {
+		attrs = append(attrs,
+			slog.String("cipher_suite", cipherSuiteName(e.CipherSuite)),
+			slog.String("version", ciphersuites.VersionName(e.Version)),
+		)
+	}
+	if e.Reason != "" {
+		attrs = append(attrs, slog.String("reason", e.Reason))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// ErrRefused is wrapped by the errors returned from the callbacks when a
+// connection is refused.
+var ErrRefused = errors.New("policy: connection refused")
+
+// Enforcer selects and enforces a policy for each connection.
+type Enforcer struct {
+	config Config
+
+	// configs caches the hardened configuration for each classification
+	// referenced by a policy.
+	configs map[ciphersuites.Classification]*tls.Config
+}
+
+// New creates an enforcer, preparing a hardened configuration for every
+// classification referenced by the configured policies. It returns an error
+// if the base configuration conflicts with any of them.
+func New(config Config) (*Enforcer, error) {
+	if config.Base == nil {
+		config.Base = &tls.Config{}
+	}
+
+	e := &Enforcer{
+		config:  config,
+		configs: make(map[ciphersuites.Classification]*tls.Config),
+	}
+
+	// Server names are matched case-insensitively.
+	serverNames := make(map[string]Policy, len(config.ServerNames))
+	policies := []Policy{config.Default}
+	for name, p := range config.ServerNames {
+		serverNames[strings.ToLower(name)] = p
+		policies = append(policies, p)
+	}
+	e.config.ServerNames = serverNames
+
+	for _, p := range policies {
+		for _, level := range []ciphersuites.Classification{p.MinClassification, p.Downgrade} {
+			if err := e.prepare(level); err != nil {
+				return nil, fmt.Errorf("policy %q: %w", p.Name, err)
+			}
+		}
+	}
+
+	return e, nil
+}
+
+func (e *Enforcer) prepare(level ciphersuites.Classification) error {
+	if _, ok := e.configs[level]; ok {
+		return nil
+	}
+
+	cfg := e.config.Base.Clone()
+	cfg.GetConfigForClient = nil
+	verify := e.config.Base.VerifyConnection
+	cfg.VerifyConnection = func(state tls.ConnectionState) error {
+		if err := e.VerifyConnection(state); err != nil {
+			return err
+		}
+		if verify != nil {
+			return verify(state)
+		}
+		return nil
+	}
+
+	// A policy with no minimum classification leaves the cipher suites of the
+	// base configuration in place.
+	if level != ciphersuites.Unknown {
+		cfg.CipherSuites = nil
+		if err := ciphersuites.Harden(cfg, level); err != nil {
+			return err
+		}
+	}
+
+	e.configs[level] = cfg
+	return nil
+}
+
+// GetConfigForClient implements [tls.Config.GetConfigForClient]. It returns a
+// configuration restricted to the cipher suites permitted by the policy for
+// the requested server name, or an error if the client offers none of them.
+func (e *Enforcer) GetConfigForClient(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	p := e.serverPolicy(hello.ServerName)
+	event := Event{
+		Stage:          StageClientHello,
+		Policy:         p.Name,
+		ServerName:     hello.ServerName,
+		Offered:        hello.CipherSuites,
+		Classification: strongestOffered(hello.CipherSuites),
+	}
+	if hello.Conn != nil {
+		event.RemoteAddr = hello.Conn.RemoteAddr().String()
+	}
+
+	switch {
+	case event.Classification.AtLeast(p.MinClassification):
+		event.Action = Allowed
+		e.emit(event)
+		return e.configs[p.MinClassification], nil
+	case p.Downgrade != ciphersuites.Unknown && event.Classification.AtLeast(p.Downgrade):
+		event.Action = Downgraded
+		event.Reason = fmt.Sprintf("client offers no %s cipher suites", p.MinClassification)
+		e.emit(event)
+		return e.configs[p.Downgrade], nil
+	default:
+		event.Action = Refused
+		event.Reason = fmt.Sprintf("strongest offered cipher suite is %s", event.Classification)
+		e.emit(event)
+		return nil, fmt.Errorf("%w: %s", ErrRefused, event.Reason)
+	}
+}
+
+// VerifyConnection implements [tls.Config.VerifyConnection]. It checks the
+// negotiated cipher suite against the policy for the connection, which is the
+// stricter of the policy selected from the server name and any policy
+// selected from the client certificate.
+//
+// The configurations returned by [Enforcer.GetConfigForClient] call it before
+// the VerifyConnection callback of the base configuration, if any.
+func (e *Enforcer) VerifyConnection(state tls.ConnectionState) error {
+	p := e.serverPolicy(state.ServerName)
+	if e.config.ClientCertificate != nil && len(state.PeerCertificates) > 0 {
+		if certPolicy, ok := e.config.ClientCertificate(state.PeerCertificates[0]); ok {
+			p = stricter(p, certPolicy)
+		}
+	}
+
+	name := cipherSuiteName(state.CipherSuite)
+	event := Event{
+		Stage:          StageVerifyConnection,
+		Policy:         p.Name,
+		ServerName:     state.ServerName,
+		CipherSuite:    state.CipherSuite,
+		Version:        state.Version,
+		Classification: ciphersuites.Unknown,
+	}
+	if cs, ok := ciphersuites.GetCipherSuiteByID(state.CipherSuite); ok {
+		event.Classification = cs.ClassificationAt(state.Version)
+	}
+
+	switch {
+	case event.Classification.AtLeast(p.MinClassification):
+		event.Action = Allowed
+		e.emit(event)
+		return nil
+	case p.Downgrade != ciphersuites.Unknown && event.Classification.AtLeast(p.Downgrade):
+		event.Action = Downgraded
+		event.Reason = fmt.Sprintf("%s is below %s", name, p.MinClassification)
+		e.emit(event)
+		return nil
+	default:
+		event.Action = Refused
+		event.Reason = fmt.Sprintf("%s is %s", name, event.Classification)
+		e.emit(event)
+		return fmt.Errorf("%w: %s", ErrRefused, event.Reason)
+	}
+}
+
+func (e *Enforcer) serverPolicy(serverName string) Policy {
+	if p, ok := e.config.ServerNames[strings.ToLower(serverName)]; ok {
+		return p
+	}
+	return e.config.Default
+}
+
+// stricter returns whichever of a and b requires the stronger classification.
+// When their minimums are equal, the one allowing fewer downgrades is
+// returned.
+func stricter(a, b Policy) Policy {
+	if a.MinClassification != b.MinClassification {
+		if a.MinClassification.AtLeast(b.MinClassification) {
+			return a
+		}
+		return b
+	}
+	if a.Downgrade == ciphersuites.Unknown {
+		return a
+	}
+	if b.Downgrade == ciphersuites.Unknown || b.Downgrade.AtLeast(a.Downgrade) {
+		return b
+	}
+	return a
+}
+
+// cipherSuiteName returns the IANA name of the cipher suite with the given
+// identifier, or the identifier in hexadecimal if it is not known.
+func cipherSuiteName(id uint16) string {
+	if name, ok := ciphersuites.CipherSuiteName(id); ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04X", id)
+}
+
+func (e *Enforcer) emit(event Event) {
+	if e.config.OnEvent != nil {
+		e.config.OnEvent(event)
+	}
+}
+
+// implemented is the set of cipher suites crypto/tls is able to negotiate,
+// including the TLS 1.3 cipher suites.
+var implemented = func() map[uint16]bool {
+	ids := make(map[uint16]bool)
+	for _, cs := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		ids[cs.ID] = true
+	}
+	return ids
+}()
+
+// strongestOffered returns the strongest classification among the offered
+// cipher suites that crypto/tls is able to negotiate. Cipher suites it does
+// not implement, such as the ARIA, CCM and PSK cipher suites, are ignored, as
+// offering them cannot lead to their being negotiated.
+func strongestOffered(offered []uint16) ciphersuites.Classification {
+	strongest := ciphersuites.Unknown
+	for _, id := range offered {
+		if !implemented[id] {
+			continue
+		}
+		cs, ok := ciphersuites.GetCipherSuiteByID(id)
+		if ok && !strongest.AtLeast(cs.Classification) {
+			strongest = cs.Classification
+		}
+	}
+	return strongest
+}
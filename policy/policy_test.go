@@ -0,0 +1,395 @@
+package policy_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/internal/testcert"
+	"github.com/tomasbasham/ciphersuites/policy"
+)
+
+func TestEnforcer(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		serverName  string
+		offered     []uint16
+		wantSuite   uint16
+		wantActions []policy.Action
+		wantErr     bool
+	}{
+		"allows recommended suite": {
+			serverName:  testcert.ServerName,
+			offered:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+			wantSuite:   tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			wantActions: []policy.Action{policy.Allowed, policy.Allowed},
+		},
+		"refuses weak suite": {
+			serverName:  testcert.ServerName,
+			offered:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+			wantActions: []policy.Action{policy.Refused},
+			wantErr:     true,
+		},
+		"downgrades weak suite for legacy server name": {
+			serverName:  "legacy.example.test",
+			offered:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+			wantSuite:   tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+			wantActions: []policy.Action{policy.Downgraded, policy.Downgraded},
+		},
+		"refuses insecure suite for legacy server name": {
+			serverName:  "legacy.example.test",
+			offered:     []uint16{tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA},
+			wantActions: []policy.Action{policy.Refused},
+			wantErr:     true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var rec recorder
+			enforcer, err := policy.New(policy.Config{
+				Base:    &tls.Config{Certificates: []tls.Certificate{testcert.ECDSA()}},
+				Default: policy.Policy{Name: "default", MinClassification: ciphersuites.Secure},
+				ServerNames: map[string]policy.Policy{
+					"Legacy.Example.Test": {
+						Name:              "legacy",
+						MinClassification: ciphersuites.Secure,
+						Downgrade:         ciphersuites.Weak,
+					},
+				},
+				OnEvent: rec.record,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			state, err := handshake(&tls.Config{GetConfigForClient: enforcer.GetConfigForClient}, &tls.Config{
+				ServerName:         tt.serverName,
+				InsecureSkipVerify: true,
+				MaxVersion:         tls.VersionTLS12,
+				CipherSuites:       tt.offered,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mismatch:\n  got:  %v\n  want error: %t", err, tt.wantErr)
+			}
+			if err == nil && state.CipherSuite != tt.wantSuite {
+				t.Errorf("mismatch:\n  got:  %s\n  want: %s", tls.CipherSuiteName(state.CipherSuite), tls.CipherSuiteName(tt.wantSuite))
+			}
+
+			if got := rec.actions(); !actionsEqual(got, tt.wantActions) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.wantActions)
+			}
+		})
+	}
+}
+
+func TestEnforcerClientCertificatePolicy(t *testing.T) {
+	t.Parallel()
+
+	var rec recorder
+	enforcer, err := policy.New(policy.Config{
+		Base: &tls.Config{
+			Certificates: []tls.Certificate{testcert.ECDSA()},
+			ClientAuth:   tls.RequireAnyClientCert,
+		},
+		Default: policy.Policy{Name: "default", MinClassification: ciphersuites.Weak},
+		ClientCertificate: func(cert *x509.Certificate) (policy.Policy, bool) {
+			return policy.Policy{Name: "strict", MinClassification: ciphersuites.Recommended}, cert.Subject.CommonName == testcert.ServerName
+		},
+		OnEvent: rec.record,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = handshake(&tls.Config{GetConfigForClient: enforcer.GetConfigForClient}, &tls.Config{
+		InsecureSkipVerify: true,
+		MaxVersion:         tls.VersionTLS12,
+		CipherSuites:       []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+		Certificates:       []tls.Certificate{testcert.ECDSA()},
+	})
+	if err == nil {
+		t.Fatal("expected handshake to be refused")
+	}
+
+	events := rec.snapshot()
+	last := events[len(events)-1]
+	if last.Stage != policy.StageVerifyConnection || last.Policy != "strict" || last.Action != policy.Refused {
+		t.Errorf("unexpected final event: %+v", last)
+	}
+}
+
+func TestEnforcerClientCertificatePolicyIsNotLaxer(t *testing.T) {
+	t.Parallel()
+
+	cert, err := x509.ParseCertificate(testcert.ECDSA().Certificate[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var rec recorder
+	enforcer, err := policy.New(policy.Config{
+		Default: policy.Policy{Name: "default", MinClassification: ciphersuites.Secure},
+		ClientCertificate: func(*x509.Certificate) (policy.Policy, bool) {
+			return policy.Policy{Name: "lax", MinClassification: ciphersuites.Weak}, true
+		},
+		OnEvent: rec.record,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = enforcer.VerifyConnection(tls.ConnectionState{
+		Version:          tls.VersionTLS12,
+		CipherSuite:      tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+		PeerCertificates: []*x509.Certificate{cert},
+	})
+	if !errors.Is(err, policy.ErrRefused) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, policy.ErrRefused)
+	}
+
+	events := rec.snapshot()
+	if len(events) != 1 || events[0].Policy != "default" {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: a single event for the default policy", events)
+	}
+}
+
+func TestEnforcerRefusesDeprecatedVersion(t *testing.T) {
+	t.Parallel()
+
+	var rec recorder
+	enforcer, err := policy.New(policy.Config{
+		Default: policy.Policy{Name: "default", MinClassification: ciphersuites.Weak},
+		OnEvent: rec.record,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = enforcer.VerifyConnection(tls.ConnectionState{
+		Version:     tls.VersionTLS10,
+		CipherSuite: tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+	})
+	if !errors.Is(err, policy.ErrRefused) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, policy.ErrRefused)
+	}
+
+	events := rec.snapshot()
+	if len(events) != 1 || events[0].Classification != ciphersuites.Insecure {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: a single insecure event", events)
+	}
+}
+
+func TestEnforcerCallsBaseVerifyConnection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	enforcer, err := policy.New(policy.Config{
+		Base: &tls.Config{
+			Certificates: []tls.Certificate{testcert.ECDSA()},
+			VerifyConnection: func(tls.ConnectionState) error {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				return nil
+			},
+		},
+		Default: policy.Policy{Name: "default", MinClassification: ciphersuites.Secure},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = handshake(&tls.Config{GetConfigForClient: enforcer.GetConfigForClient}, &tls.Config{
+		InsecureSkipVerify: true,
+		MaxVersion:         tls.VersionTLS12,
+		CipherSuites:       []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 1 {
+		t.Errorf("mismatch:\n  got:  %d calls\n  want: 1 call", calls)
+	}
+}
+
+func TestEnforcerWithoutMinimum(t *testing.T) {
+	t.Parallel()
+
+	var rec recorder
+	enforcer, err := policy.New(policy.Config{
+		Base:    &tls.Config{Certificates: []tls.Certificate{testcert.ECDSA()}},
+		Default: policy.Policy{Name: "default"},
+		OnEvent: rec.record,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state, err := handshake(&tls.Config{GetConfigForClient: enforcer.GetConfigForClient}, &tls.Config{
+		InsecureSkipVerify: true,
+		MaxVersion:         tls.VersionTLS12,
+		CipherSuites:       []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.CipherSuite != tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA {
+		t.Errorf("mismatch:\n  got:  %s\n  want: %s", tls.CipherSuiteName(state.CipherSuite), tls.CipherSuiteName(tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA))
+	}
+
+	want := []policy.Action{policy.Allowed, policy.Allowed}
+	if got := rec.actions(); !actionsEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, want)
+	}
+}
+
+func TestEnforcerIgnoresUnimplementedSuites(t *testing.T) {
+	t.Parallel()
+
+	var rec recorder
+	enforcer, err := policy.New(policy.Config{
+		Base: &tls.Config{Certificates: []tls.Certificate{testcert.ECDSA()}},
+		Default: policy.Policy{
+			Name:              "legacy",
+			MinClassification: ciphersuites.Secure,
+			Downgrade:         ciphersuites.Weak,
+		},
+		OnEvent: rec.record,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// crypto/tls does not implement ARIA, so the secure ARIA suite cannot be
+	// negotiated and only the weak suite counts. A crypto/tls client never
+	// offers it, so the ClientHello is passed in directly.
+	aria, ok := ciphersuites.CipherSuiteID("TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256")
+	if !ok {
+		t.Fatal("TLS_ECDHE_ECDSA_WITH_ARIA_128_GCM_SHA256 is not registered")
+	}
+	_, err = enforcer.GetConfigForClient(&tls.ClientHelloInfo{
+		CipherSuites: []uint16{aria, tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []policy.Action{policy.Downgraded}
+	if got := rec.actions(); !actionsEqual(got, want) {
+		t.Fatalf("mismatch:\n  got:  %v\n  want: %v", got, want)
+	}
+	if got := rec.snapshot()[0].Classification; got != ciphersuites.Weak {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Weak)
+	}
+}
+
+func TestNewRejectsConflictingBase(t *testing.T) {
+	t.Parallel()
+
+	_, err := policy.New(policy.Config{
+		Base:    &tls.Config{MinVersion: tls.VersionTLS10},
+		Default: policy.Policy{Name: "default", MinClassification: ciphersuites.Recommended},
+	})
+
+	var conflictErr *ciphersuites.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: *ciphersuites.ConflictError", err)
+	}
+}
+
+// handshake connects a client and server over loopback TCP, returning the
+// server's view of the connection. An unbuffered net.Pipe cannot be used
+// because both sides may write at once when a handshake is aborted.
+func handshake(server, client *tls.Config) (tls.ConnectionState, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer l.Close()
+
+	c, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer c.Close()
+
+	s, err := l.Accept()
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer s.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		conn := tls.Client(c, client)
+		err := conn.Handshake()
+		if err == nil {
+			// TLS 1.3 servers verify client certificates after the client has
+			// finished, so wait for the server to respond.
+			_, err = conn.Read(make([]byte, 1))
+		}
+		c.Close()
+		done <- err
+	}()
+
+	conn := tls.Server(s, server)
+	err = conn.Handshake()
+	if err == nil {
+		_, err = conn.Write([]byte{0})
+	}
+	s.Close()
+	<-done
+
+	return conn.ConnectionState(), err
+}
+
+type recorder struct {
+	mu     sync.Mutex
+	events []policy.Event
+}
+
+func (r *recorder) record(e policy.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) snapshot() []policy.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]policy.Event(nil), r.events...)
+}
+
+func (r *recorder) actions() []policy.Action {
+	var actions []policy.Action
+	for _, e := range r.snapshot() {
+		actions = append(actions, e.Action)
+	}
+	return actions
+}
+
+func actionsEqual(a, b []policy.Action) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
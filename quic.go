@@ -0,0 +1,24 @@
+package ciphersuites
+
+// quicHeaderProtection lists the AEAD algorithms of TLS 1.3 cipher suites for
+// which RFC 9001, section 5.4, defines a header protection scheme: AES, in
+// section 5.4.3, and ChaCha20, in section 5.4.4. AES CCM with a truncated tag
+// is left out because section 5.3 excludes TLS_AES_128_CCM_8_SHA256.
+var quicHeaderProtection = map[string]bool{
+	"AES 128 GCM":       true,
+	"AES 256 GCM":       true,
+	"AES 128 CCM":       true,
+	"CHACHA20 POLY1305": true,
+}
+
+// QUICAllowed reports whether the cipher suite can protect QUIC packets. QUIC
+// only uses TLS 1.3 cipher suites whose AEAD has a header protection scheme
+// defined by RFC 9001, so TLS 1.3 cipher suites such as
+// TLS_AES_128_CCM_8_SHA256, TLS_SM4_GCM_SM3 and the integrity-only
+// TLS_SHA256_SHA256 are not allowed.
+func (a CipherSuite) QUICAllowed() bool {
+	if len(a.TLSVersions) != 1 || a.TLSVersions[0] != "TLS1.3" {
+		return false
+	}
+	return quicHeaderProtection[a.EncryptionAlgorithm]
+}
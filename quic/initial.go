@@ -0,0 +1,258 @@
+package quic
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"sort"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/handshake"
+)
+
+// Frame types that may appear in an Initial packet (RFC 9000, section 12.4).
+const (
+	framePadding         = 0x00
+	framePing            = 0x01
+	frameACK             = 0x02
+	frameACKECN          = 0x03
+	frameCrypto          = 0x06
+	frameConnectionClose = 0x1c
+)
+
+// Packet is a decrypted Initial packet.
+type Packet struct {
+	Version                 uint32
+	DestinationConnectionID []byte
+	SourceConnectionID      []byte
+	Token                   []byte
+	PacketNumber            uint32
+
+	// Payload holds the decrypted frames of the packet.
+	Payload []byte
+}
+
+// DecryptInitial removes the header protection from, and decrypts, the client
+// Initial packet at the start of datagram. It returns the packet and the rest
+// of the datagram, which may hold further coalesced packets.
+func DecryptInitial(datagram []byte) (*Packet, []byte, error) {
+	if len(datagram) < 5 || datagram[0]&0x80 == 0 {
+		return nil, nil, ErrNotInitial
+	}
+
+	p := &Packet{
+		Version: uint32(datagram[1])<<24 | uint32(datagram[2])<<16 | uint32(datagram[3])<<8 | uint32(datagram[4]),
+	}
+	if p.Version == 0 {
+		// Version Negotiation packets have no packet type.
+		return nil, nil, ErrNotInitial
+	}
+	v, ok := versions[p.Version]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w 0x%08x", ErrUnsupportedVersion, p.Version)
+	}
+	if datagram[0]>>4&0x03 != v.initialType {
+		return nil, nil, ErrNotInitial
+	}
+
+	s := reader(datagram[5:])
+	var dcid, scid, token reader
+	var length uint64
+	if !s.readBytes8(&dcid) ||
+		!s.readBytes8(&scid) ||
+		!s.readVarintBytes(&token) ||
+		!s.readVarint(&length) {
+		return nil, nil, ErrTruncated
+	}
+	p.DestinationConnectionID = dcid
+	p.SourceConnectionID = scid
+	p.Token = token
+
+	// The header protection sample is taken as if the packet number were
+	// four bytes long.
+	if length < 4+aes.BlockSize || uint64(len(s)) < length {
+		return nil, nil, ErrTruncated
+	}
+	pnOffset := len(datagram) - len(s)
+	end := pnOffset + int(length)
+
+	k := v.clientInitialKeys(p.DestinationConnectionID)
+	hp, err := aes.NewCipher(k.hp)
+	if err != nil {
+		return nil, nil, err
+	}
+	mask := make([]byte, aes.BlockSize)
+	hp.Encrypt(mask, datagram[pnOffset+4:pnOffset+4+aes.BlockSize])
+
+	header := append([]byte(nil), datagram[:pnOffset+4]...)
+	header[0] ^= mask[0] & 0x0f
+	pnLength := int(header[0]&0x03) + 1
+	for i := 0; i < pnLength; i++ {
+		header[pnOffset+i] ^= mask[1+i]
+		p.PacketNumber = p.PacketNumber<<8 | uint32(header[pnOffset+i])
+	}
+	header = header[:pnOffset+pnLength]
+
+	block, err := aes.NewCipher(k.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := append([]byte(nil), k.iv...)
+	for i := 0; i < 4; i++ {
+		nonce[len(nonce)-1-i] ^= byte(p.PacketNumber >> (8 * i))
+	}
+	p.Payload, err = aead.Open(nil, nonce, datagram[pnOffset+pnLength:end], header)
+	if err != nil {
+		return nil, nil, ErrDecryption
+	}
+
+	return p, datagram[end:], nil
+}
+
+// cryptoFrame is the data carried by a CRYPTO frame.
+type cryptoFrame struct {
+	offset uint64
+	data   []byte
+}
+
+// cryptoFrames returns the CRYPTO frames in the packet, skipping the other
+// frames permitted in Initial packets.
+func (p *Packet) cryptoFrames() ([]cryptoFrame, error) {
+	var frames []cryptoFrame
+	s := reader(p.Payload)
+	for !s.empty() {
+		var typ uint64
+		if !s.readVarint(&typ) {
+			return nil, ErrTruncated
+		}
+
+		switch typ {
+		case framePadding, framePing:
+		case frameACK, frameACKECN:
+			var largest, delay, ranges, first uint64
+			if !s.readVarint(&largest) || !s.readVarint(&delay) || !s.readVarint(&ranges) || !s.readVarint(&first) {
+				return nil, ErrTruncated
+			}
+			fields := 2 * ranges
+			if typ == frameACKECN {
+				fields += 3
+			}
+			for i := uint64(0); i < fields; i++ {
+				var skip uint64
+				if !s.readVarint(&skip) {
+					return nil, ErrTruncated
+				}
+			}
+		case frameCrypto:
+			var f cryptoFrame
+			var data reader
+			if !s.readVarint(&f.offset) || !s.readVarintBytes(&data) {
+				return nil, ErrTruncated
+			}
+			f.data = data
+			frames = append(frames, f)
+		case frameConnectionClose:
+			var code, frameType uint64
+			var reason reader
+			if !s.readVarint(&code) || !s.readVarint(&frameType) || !s.readVarintBytes(&reason) {
+				return nil, ErrTruncated
+			}
+		default:
+			return nil, fmt.Errorf("quic: unexpected frame type 0x%02x in Initial packet", typ)
+		}
+	}
+	return frames, nil
+}
+
+// ReadClientHello decrypts the client Initial packets in the given UDP
+// datagrams, which must belong to a single connection, and parses the
+// ClientHello carried in their CRYPTO frames. ClientHellos offering large key
+// shares, such as those for post-quantum key exchange, span several
+// datagrams, and [ErrIncomplete] is returned if any part is missing.
+//
+// Packets that follow the Initial packets in a datagram, such as 0-RTT
+// packets, are ignored.
+func ReadClientHello(datagrams ...[]byte) (*handshake.ClientHello, error) {
+	var frames []cryptoFrame
+	for _, datagram := range datagrams {
+		for first := true; len(datagram) > 0; first = false {
+			p, rest, err := DecryptInitial(datagram)
+			if err != nil && first {
+				return nil, err
+			}
+			if err != nil {
+				break
+			}
+			f, err := p.cryptoFrames()
+			if err != nil {
+				return nil, err
+			}
+			frames = append(frames, f...)
+			datagram = rest
+		}
+	}
+
+	stream := reassemble(frames)
+	if len(stream) < 4 {
+		return nil, ErrIncomplete
+	}
+	length := int(stream[1])<<16 | int(stream[2])<<8 | int(stream[3])
+	if len(stream) < 4+length {
+		return nil, ErrIncomplete
+	}
+	return handshake.ParseClientHello(handshake.Message(stream[:4+length]))
+}
+
+// reassemble joins CRYPTO frames into the contiguous stream of handshake data
+// starting at offset zero. Retransmitted and overlapping data is tolerated,
+// and anything after the first gap is discarded.
+func reassemble(frames []cryptoFrame) []byte {
+	sort.SliceStable(frames, func(i, j int) bool {
+		return frames[i].offset < frames[j].offset
+	})
+
+	var stream []byte
+	for _, f := range frames {
+		if f.offset > uint64(len(stream)) {
+			break
+		}
+		if end := f.offset + uint64(len(f.data)); end > uint64(len(stream)) {
+			stream = append(stream, f.data[uint64(len(stream))-f.offset:]...)
+		}
+	}
+	return stream
+}
+
+// Suite is a cipher suite offered in a ClientHello.
+type Suite struct {
+	ID uint16
+
+	// Name is the IANA name of the cipher suite, or empty if it is not known,
+	// as for GREASE values.
+	Name           string
+	Classification ciphersuites.Classification
+
+	// QUICAllowed reports whether the cipher suite may be used with QUIC, as
+	// reported by [ciphersuites.CipherSuite.QUICAllowed].
+	QUICAllowed bool
+}
+
+// ClassifyCipherSuites classifies the cipher suites offered in hello, in the
+// order they were offered.
+func ClassifyCipherSuites(hello *handshake.ClientHello) []Suite {
+	suites := make([]Suite, 0, len(hello.CipherSuites))
+	for _, id := range hello.CipherSuites {
+		s := Suite{ID: id}
+		if cs, ok := ciphersuites.GetCipherSuiteByID(id); ok {
+			s.Name = cs.Name
+			s.Classification = cs.Classification
+			s.QUICAllowed = cs.QUICAllowed()
+		}
+		suites = append(suites, s)
+	}
+	return suites
+}
@@ -0,0 +1,122 @@
+// Package quic recovers the TLS ClientHello carried in the Initial packets
+// that open a QUIC connection, so the cipher suites a QUIC client offers can
+// be classified like those of any other TLS client.
+//
+// Initial packets are encrypted, but with keys derived from the Destination
+// Connection ID chosen by the client and a salt published for each QUIC
+// version, so anyone observing them can decrypt them. The derivation is
+// described in RFC 9001 for QUIC version 1 and RFC 9369 for QUIC version 2.
+// Only the client's packets can be decrypted without seeing the start of the
+// connection, as the server's Initial packets are protected with keys derived
+// from the client's original Destination Connection ID.
+package quic
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+)
+
+// QUIC versions whose Initial packets can be decrypted.
+const (
+	Version1 uint32 = 0x00000001
+	Version2 uint32 = 0x6b3343cf
+)
+
+// ErrNotInitial is returned when a packet is not a long header Initial
+// packet.
+var ErrNotInitial = errors.New("quic: not an Initial packet")
+
+// ErrUnsupportedVersion is returned when a packet uses a QUIC version whose
+// Initial keys are not known.
+var ErrUnsupportedVersion = errors.New("quic: unsupported version")
+
+// ErrTruncated is returned when a packet or frame is shorter than its encoded
+// lengths claim it to be.
+var ErrTruncated = errors.New("quic: truncated packet")
+
+// ErrDecryption is returned when a packet cannot be authenticated with the
+// Initial keys derived from its Destination Connection ID.
+var ErrDecryption = errors.New("quic: failed to decrypt packet")
+
+// ErrIncomplete is returned when the CRYPTO frames of the given packets do not
+// hold a complete ClientHello, which may span several Initial packets.
+var ErrIncomplete = errors.New("quic: incomplete ClientHello")
+
+// version holds the constants that differ between QUIC versions.
+type version struct {
+	salt []byte
+
+	// labelPrefix is prepended to the "key", "iv" and "hp" labels used to
+	// derive packet protection keys.
+	labelPrefix string
+
+	// initialType is the value of the long header packet type bits that
+	// identifies an Initial packet.
+	initialType byte
+}
+
+var versions = map[uint32]version{
+	Version1: {
+		salt: []byte{
+			0x38, 0x76, 0x2c, 0xf7, 0xf5, 0x59, 0x34, 0xb3, 0x4d, 0x17,
+			0x9a, 0xe6, 0xa4, 0xc8, 0x0c, 0xad, 0xcc, 0xbb, 0x7f, 0x0a,
+		},
+		labelPrefix: "quic ",
+		initialType: 0,
+	},
+	Version2: {
+		salt: []byte{
+			0x0d, 0xed, 0xe3, 0xde, 0xf7, 0x00, 0xa6, 0xdb, 0x81, 0x93,
+			0x81, 0xbe, 0x6e, 0x26, 0x9d, 0xcb, 0xf9, 0xbd, 0x2e, 0xd9,
+		},
+		labelPrefix: "quicv2 ",
+		initialType: 1,
+	},
+}
+
+// keys protect the Initial packets sent by one side of a connection.
+type keys struct {
+	key []byte
+	iv  []byte
+	hp  []byte
+}
+
+// clientInitialKeys derives the keys protecting the client's Initial packets
+// from the Destination Connection ID of its first Initial packet.
+func (v version) clientInitialKeys(dcid []byte) keys {
+	initial := hkdfExtract(v.salt, dcid)
+	secret := hkdfExpandLabel(initial, "client in", 32)
+	return keys{
+		key: hkdfExpandLabel(secret, v.labelPrefix+"key", 16),
+		iv:  hkdfExpandLabel(secret, v.labelPrefix+"iv", 12),
+		hp:  hkdfExpandLabel(secret, v.labelPrefix+"hp", 16),
+	}
+}
+
+// hkdfExtract implements HKDF-Extract from RFC 5869 with SHA-256.
+func hkdfExtract(salt, secret []byte) []byte {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(secret)
+	return mac.Sum(nil)
+}
+
+// hkdfExpandLabel implements HKDF-Expand-Label from RFC 8446, section 7.1,
+// with SHA-256 and an empty context.
+func hkdfExpandLabel(secret []byte, label string, length int) []byte {
+	label = "tls13 " + label
+	info := []byte{byte(length >> 8), byte(length), byte(len(label))}
+	info = append(info, label...)
+	info = append(info, 0)
+
+	var out, block []byte
+	for counter := byte(1); len(out) < length; counter++ {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(block)
+		mac.Write(info)
+		mac.Write([]byte{counter})
+		block = mac.Sum(nil)
+		out = append(out, block...)
+	}
+	return out[:length]
+}
@@ -0,0 +1,177 @@
+package quic_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/handshake"
+	"github.com/tomasbasham/ciphersuites/quic"
+)
+
+// The client Initial packets from RFC 9001, appendix A.2, and RFC 9369,
+// appendix A.2.
+var testPackets = map[string]struct {
+	file    string
+	version uint32
+}{
+	"QUIC v1": {
+		file:    "testdata/rfc9001-client-initial.hex",
+		version: quic.Version1,
+	},
+	"QUIC v2": {
+		file:    "testdata/rfc9369-client-initial.hex",
+		version: quic.Version2,
+	},
+}
+
+func TestDecryptInitial(t *testing.T) {
+	t.Parallel()
+
+	for name, tt := range testPackets {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, rest, err := quic.DecryptInitial(readPacket(t, tt.file))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.Version != tt.version {
+				t.Errorf("version mismatch:\n  got:  0x%08x\n  want: 0x%08x", p.Version, tt.version)
+			}
+			if got, want := hex.EncodeToString(p.DestinationConnectionID), "8394c8f03e515708"; got != want {
+				t.Errorf("connection ID mismatch:\n  got:  %s\n  want: %s", got, want)
+			}
+			if p.PacketNumber != 2 {
+				t.Errorf("packet number mismatch:\n  got:  %d\n  want: 2", p.PacketNumber)
+			}
+			// The payload is a CRYPTO frame followed by PADDING.
+			if len(p.Payload) != 1162 || p.Payload[0] != 0x06 {
+				t.Errorf("payload mismatch:\n  got:  %d bytes starting 0x%02x\n  want: 1162 bytes starting 0x06", len(p.Payload), p.Payload[0])
+			}
+			if len(rest) != 0 {
+				t.Errorf("unexpected %d bytes after the packet", len(rest))
+			}
+		})
+	}
+}
+
+func TestDecryptInitialErrors(t *testing.T) {
+	t.Parallel()
+
+	packet := readPacket(t, testPackets["QUIC v1"].file)
+
+	var tests = map[string]struct {
+		packet []byte
+		want   error
+	}{
+		"short header": {
+			packet: []byte{0x40, 0x01, 0x02, 0x03, 0x04, 0x05},
+			want:   quic.ErrNotInitial,
+		},
+		"version negotiation": {
+			packet: []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00},
+			want:   quic.ErrNotInitial,
+		},
+		"unsupported version": {
+			packet: append([]byte{0xc0, 0xff, 0x00, 0x00, 0x1d}, packet[5:]...),
+			want:   quic.ErrUnsupportedVersion,
+		},
+		"handshake packet": {
+			packet: append([]byte{0xe0}, packet[1:]...),
+			want:   quic.ErrNotInitial,
+		},
+		"truncated": {
+			packet: packet[:600],
+			want:   quic.ErrTruncated,
+		},
+		"tampered": {
+			packet: tamper(packet, 100),
+			want:   quic.ErrDecryption,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := quic.DecryptInitial(tt.packet)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadClientHello(t *testing.T) {
+	t.Parallel()
+
+	for name, tt := range testPackets {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hello, err := quic.ReadClientHello(readPacket(t, tt.file))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hello.ServerName != "example.com" {
+				t.Errorf("server name mismatch:\n  got:  %s\n  want: example.com", hello.ServerName)
+			}
+			want := []uint16{0x1301, 0x1302}
+			if !reflect.DeepEqual(hello.CipherSuites, want) {
+				t.Errorf("cipher suites mismatch:\n  got:  %v\n  want: %v", hello.CipherSuites, want)
+			}
+		})
+	}
+}
+
+func TestReadClientHelloIncomplete(t *testing.T) {
+	t.Parallel()
+
+	if _, err := quic.ReadClientHello(); !errors.Is(err, quic.ErrIncomplete) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, quic.ErrIncomplete)
+	}
+}
+
+func TestClassifyCipherSuites(t *testing.T) {
+	t.Parallel()
+
+	hello := &handshake.ClientHello{
+		CipherSuites: []uint16{0x0a0a, 0x1301, 0x1305, 0xc02f},
+	}
+	want := []quic.Suite{
+		{ID: 0x0a0a},
+		{ID: 0x1301, Name: "TLS_AES_128_GCM_SHA256", Classification: ciphersuites.Recommended, QUICAllowed: true},
+		{ID: 0x1305, Name: "TLS_AES_128_CCM_8_SHA256", Classification: ciphersuites.Secure},
+		{ID: 0xc02f, Name: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", Classification: ciphersuites.Recommended},
+	}
+
+	if got := quic.ClassifyCipherSuites(hello); !reflect.DeepEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, want)
+	}
+}
+
+func readPacket(t *testing.T, file string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("failed to read packet: %v", err)
+	}
+	packet, err := hex.DecodeString(strings.Join(strings.Fields(string(b)), ""))
+	if err != nil {
+		t.Fatalf("failed to decode packet: %v", err)
+	}
+	return packet
+}
+
+func tamper(packet []byte, i int) []byte {
+	tampered := append([]byte(nil), packet...)
+	tampered[i] ^= 0x01
+	return tampered
+}
@@ -0,0 +1,57 @@
+package quic
+
+// reader consumes the fields of a QUIC packet, which are big-endian and
+// either fixed-length or encoded as variable-length integers (RFC 9000,
+// section 16).
+type reader []byte
+
+func (r *reader) empty() bool {
+	return len(*r) == 0
+}
+
+func (r *reader) readN(n uint64, out *reader) bool {
+	if uint64(len(*r)) < n {
+		return false
+	}
+	*out = (*r)[:n]
+	*r = (*r)[n:]
+	return true
+}
+
+func (r *reader) readBytes8(out *reader) bool {
+	if len(*r) < 1 {
+		return false
+	}
+	n := (*r)[0]
+	*r = (*r)[1:]
+	return r.readN(uint64(n), out)
+}
+
+// readVarint reads a variable-length integer, whose length is encoded in the
+// two most significant bits of its first byte.
+func (r *reader) readVarint(out *uint64) bool {
+	if len(*r) < 1 {
+		return false
+	}
+	n := 1 << ((*r)[0] >> 6)
+	if len(*r) < n {
+		return false
+	}
+	v := uint64((*r)[0] & 0x3f)
+	for _, b := range (*r)[1:n] {
+		v = v<<8 | uint64(b)
+	}
+	*out = v
+	*r = (*r)[n:]
+	return true
+}
+
+// readVarintBytes reads a byte string prefixed with its length as a
+// variable-length integer.
+func (r *reader) readVarintBytes(out *reader) bool {
+	var n uint64
+	if !r.readVarint(&n) {
+		return false
+	}
+	return r.readN(n, out)
+}
@@ -0,0 +1,59 @@
+package ciphersuites_test
+
+import (
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestQUICAllowed(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite string
+		want        bool
+	}{
+		"AES GCM": {
+			cipherSuite: "TLS_AES_128_GCM_SHA256",
+			want:        true,
+		},
+		"ChaCha20-Poly1305": {
+			cipherSuite: "TLS_CHACHA20_POLY1305_SHA256",
+			want:        true,
+		},
+		"AES CCM": {
+			cipherSuite: "TLS_AES_128_CCM_SHA256",
+			want:        true,
+		},
+		"truncated tag": {
+			cipherSuite: "TLS_AES_128_CCM_8_SHA256",
+			want:        false,
+		},
+		"no header protection scheme": {
+			cipherSuite: "TLS_SM4_GCM_SM3",
+			want:        false,
+		},
+		"integrity only": {
+			cipherSuite: "TLS_SHA256_SHA256",
+			want:        false,
+		},
+		"TLS 1.2": {
+			cipherSuite: "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+			want:        false,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cs, ok := ciphersuites.GetCipherSuite(tt.cipherSuite)
+			if !ok {
+				t.Fatal("cipher suite not found")
+			}
+			if got := cs.QUICAllowed(); got != tt.want {
+				t.Errorf("mismatch:\n  got:  %t\n  want: %t", got, tt.want)
+			}
+		})
+	}
+}
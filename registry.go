@@ -0,0 +1,233 @@
+package ciphersuites
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// Registry is a set of cipher suite classifications. The package-level
+// lookups, such as [GetCipherSuite], use a default registry holding the data
+// compiled into the package; a Registry can hold different data, so that
+// tests can substitute their own classifications and policies built on
+// different data can coexist in one process.
+//
+// A Registry is safe for concurrent use. Loading new data replaces it
+// atomically, so lookups running concurrently see either the old or the new
+// data, never a mixture. The zero value is an empty registry.
+type Registry struct {
+	data atomic.Value // *dataset
+}
+
+// defaultRegistry is used by the package-level functions.
+var defaultRegistry = NewCompiledRegistry()
+
+// DefaultRegistry returns the registry used by the package-level functions.
+// Data loaded into it with [Registry.Load] is seen by every package-level
+// lookup.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewCompiledRegistry returns a registry holding the data compiled into the
+// package.
+func NewCompiledRegistry() *Registry {
+	r := &Registry{}
+	r.data.Store(compiledDataset())
+	return r
+}
+
+// NewRegistry returns a registry holding suites, which are looked up by
+// their Name and, if it is not zero, their ID. It returns a [*DatasetError]
+// if any cipher suite has no name or shares its name or ID with another.
+func NewRegistry(suites []CipherSuite) (*Registry, error) {
+	d := &dataset{
+		suites: make(map[string]CipherSuite, len(suites)),
+		names:  make(map[uint16]string, len(suites)),
+	}
+
+	var problems []string
+	for i, cs := range suites {
+		_, ok := d.suites[cs.Name]
+		other, shared := d.names[cs.ID]
+		switch {
+		case cs.Name == "":
+			problems = append(problems, fmt.Sprintf("cipher suite %d has no name", i))
+		case ok:
+			problems = append(problems, fmt.Sprintf("%s is listed more than once", cs.Name))
+		case cs.ID != 0 && shared:
+			problems = append(problems, fmt.Sprintf("%s shares ID 0x%04X with %s", cs.Name, cs.ID, other))
+		default:
+			d.suites[cs.Name] = cs
+			if cs.ID != 0 {
+				d.names[cs.ID] = cs.Name
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &DatasetError{Problems: problems}
+	}
+
+	r := &Registry{}
+	r.data.Store(d)
+	return r, nil
+}
+
+// LoadRegistry returns a registry holding the JSON dataset read from rd. See
+// [Registry.Load].
+func LoadRegistry(rd io.Reader) (*Registry, error) {
+	r := &Registry{}
+	if err := r.Load(rd); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// LoadRegistryFile returns a registry holding the JSON dataset read from the
+// named file.
+func LoadRegistryFile(path string) (*Registry, error) {
+	r := &Registry{}
+	if err := r.LoadFile(path); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Registry) dataset() *dataset {
+	if d, ok := r.data.Load().(*dataset); ok {
+		return d
+	}
+	return &dataset{}
+}
+
+// Load reads a JSON dataset, as written by the generator's -json flag, and
+// replaces the data held by the registry. The dataset is validated in full
+// before it is used, so an invalid dataset leaves the current data in place.
+func (r *Registry) Load(rd io.Reader) error {
+	d, err := readDataset(rd)
+	if err != nil {
+		return err
+	}
+	r.data.Store(d)
+	return nil
+}
+
+// LoadFile is like [Registry.Load] but reads the dataset from the named file.
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := r.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Source returns the URL of the registry the data was generated from, or
+// empty if it is not known.
+func (r *Registry) Source() string {
+	return r.dataset().source
+}
+
+// Checksum returns the hex-encoded SHA-256 digest of the registry file the
+// data was generated from, or empty if it is not known.
+func (r *Registry) Checksum() string {
+	return r.dataset().checksum
+}
+
+// GetCipherSuite retrieves the [CipherSuite] by its name.
+func (r *Registry) GetCipherSuite(cipherSuite string) (CipherSuite, bool) {
+	cs, ok := r.dataset().suites[cipherSuite]
+	return cs, ok
+}
+
+// GetCipherSuiteByID retrieves the [CipherSuite] by its IANA assigned
+// identifier. See [GetCipherSuiteByID].
+func (r *Registry) GetCipherSuiteByID(id uint16) (CipherSuite, bool) {
+	d := r.dataset()
+	name, ok := d.nameByID(id)
+	if !ok {
+		return CipherSuite{}, false
+	}
+	return d.suites[name], true
+}
+
+// CipherSuiteName returns the name of the cipher suite with the given
+// identifier. See [CipherSuiteName].
+func (r *Registry) CipherSuiteName(id uint16) (string, bool) {
+	return r.dataset().nameByID(id)
+}
+
+// CipherSuiteID returns the identifier of the named cipher suite. See
+// [CipherSuiteID].
+func (r *Registry) CipherSuiteID(name string) (uint16, bool) {
+	return r.dataset().idByName(name)
+}
+
+// GetClassification returns the security classification of a given cipher
+// suite. If the cipher suite cannot be found then its classification is
+// unknown.
+func (r *Registry) GetClassification(cipherSuite string) Classification {
+	if cs, ok := r.GetCipherSuite(cipherSuite); ok {
+		return cs.Classification
+	}
+
+	return Unknown
+}
+
+// CipherSuites returns every cipher suite in the registry, ordered by name.
+func (r *Registry) CipherSuites() []CipherSuite {
+	return r.filter(func(CipherSuite) bool { return true })
+}
+
+// CipherSuitesClassified returns the cipher suites with the given
+// classification, ordered by name.
+func (r *Registry) CipherSuitesClassified(c Classification) []CipherSuite {
+	return r.filter(func(cs CipherSuite) bool { return cs.Classification == c })
+}
+
+// SuitesWithStrengthAtLeast returns the cipher suites providing at least the
+// given number of bits of security, ordered by name.
+func (r *Registry) SuitesWithStrengthAtLeast(bits int) []CipherSuite {
+	return r.filter(func(cs CipherSuite) bool { return cs.SecurityBits >= bits })
+}
+
+// DeprecatedBy returns the names of the cipher suites that were, or will have
+// been, deprecated on or before date. See [DeprecatedBy].
+func (r *Registry) DeprecatedBy(date time.Time) []string {
+	return names(r.filter(func(cs CipherSuite) bool { return cs.IsDeprecated(date) }))
+}
+
+// RemovedBy returns the names of the cipher suites whose support was, or will
+// have been, removed from a major implementation on or before date. See
+// [RemovedBy].
+func (r *Registry) RemovedBy(date time.Time) []string {
+	return names(r.filter(func(cs CipherSuite) bool { return cs.IsRemoved(date) }))
+}
+
+func (r *Registry) filter(keep func(CipherSuite) bool) []CipherSuite {
+	var suites []CipherSuite
+	for _, cs := range r.dataset().suites {
+		if keep(cs) {
+			suites = append(suites, cs)
+		}
+	}
+	sort.Slice(suites, func(i, j int) bool {
+		return suites[i].Name < suites[j].Name
+	})
+	return suites
+}
+
+func names(suites []CipherSuite) []string {
+	var names []string
+	for _, cs := range suites {
+		names = append(names, cs.Name)
+	}
+	return names
+}
@@ -0,0 +1,132 @@
+package ciphersuites_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, err := ciphersuites.NewRegistry([]ciphersuites.CipherSuite{
+		{ID: 0x009C, Name: "TLS_RSA_WITH_AES_128_GCM_SHA256", Classification: ciphersuites.Recommended},
+		{Name: "TLS_AES_128_GCM_SHA256", Classification: ciphersuites.Recommended},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := registry.GetClassification("TLS_RSA_WITH_AES_128_GCM_SHA256"); got != ciphersuites.Recommended {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, ciphersuites.Recommended)
+	}
+	if got := ciphersuites.GetClassification("TLS_RSA_WITH_AES_128_GCM_SHA256"); got == ciphersuites.Recommended {
+		t.Errorf("mismatch:\n  got:  %v\n  want: the default registry is unaffected", got)
+	}
+	if _, ok := registry.GetCipherSuite("TLS_AES_256_GCM_SHA384"); ok {
+		t.Error("mismatch:\n  got:  found\n  want: not found")
+	}
+	if cs, _ := registry.GetCipherSuiteByID(0x009C); cs.Name != "TLS_RSA_WITH_AES_128_GCM_SHA256" {
+		t.Errorf("mismatch:\n  got:  %q\n  want: %q", cs.Name, "TLS_RSA_WITH_AES_128_GCM_SHA256")
+	}
+	if _, ok := registry.CipherSuiteID("TLS_AES_128_GCM_SHA256"); ok {
+		t.Error("mismatch:\n  got:  found\n  want: a cipher suite without an ID is not found by ID")
+	}
+
+	var names []string
+	for _, cs := range registry.CipherSuites() {
+		names = append(names, cs.Name)
+	}
+	want := "TLS_AES_128_GCM_SHA256,TLS_RSA_WITH_AES_128_GCM_SHA256"
+	if got := strings.Join(names, ","); got != want {
+		t.Errorf("mismatch:\n  got:  %s\n  want: %s", got, want)
+	}
+}
+
+func TestNewRegistryInvalid(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		suites []ciphersuites.CipherSuite
+	}{
+		"missing name": {
+			suites: []ciphersuites.CipherSuite{{Classification: ciphersuites.Secure}},
+		},
+		"duplicate name": {
+			suites: []ciphersuites.CipherSuite{
+				{Name: "TLS_AES_128_GCM_SHA256"},
+				{Name: "TLS_AES_128_GCM_SHA256"},
+			},
+		},
+		"duplicate ID": {
+			suites: []ciphersuites.CipherSuite{
+				{ID: 0x1301, Name: "TLS_AES_128_GCM_SHA256"},
+				{ID: 0x1301, Name: "TLS_AES_256_GCM_SHA384"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ciphersuites.NewRegistry(tt.suites)
+			var datasetErr *ciphersuites.DatasetError
+			if !errors.As(err, &datasetErr) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: *ciphersuites.DatasetError", err)
+			}
+		})
+	}
+}
+
+func TestCompiledRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := ciphersuites.NewCompiledRegistry()
+	for _, c := range []ciphersuites.Classification{
+		ciphersuites.Recommended,
+		ciphersuites.Secure,
+		ciphersuites.Weak,
+		ciphersuites.Insecure,
+	} {
+		if len(registry.CipherSuitesClassified(c)) == 0 {
+			t.Errorf("mismatch:\n  got:  no %s cipher suites\n  want: at least one", c)
+		}
+	}
+
+	want := len(ciphersuites.RecommendedCipherSuites) + len(ciphersuites.SecureCipherSuites) +
+		len(ciphersuites.WeakCipherSuites) + len(ciphersuites.InsecureCipherSuites)
+	if got := len(registry.CipherSuites()); got != want {
+		t.Errorf("mismatch:\n  got:  %d\n  want: %d", got, want)
+	}
+}
+
+func TestRegistryConcurrentLoad(t *testing.T) {
+	t.Parallel()
+
+	registry := ciphersuites.NewCompiledRegistry()
+	dataset := `{"version": 1, "cipher_suites": [{"name": "TLS_AES_128_GCM_SHA256", "classification": "weak"}]}`
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := registry.Load(strings.NewReader(dataset)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			switch got := registry.GetClassification("TLS_AES_128_GCM_SHA256"); got {
+			case ciphersuites.Recommended, ciphersuites.Weak:
+			default:
+				t.Errorf("mismatch:\n  got:  %v\n  want: recommended or weak", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"html/template"
+	"io"
+)
+
+// htmlTemplate is self-contained so that the report can be archived or
+// attached to a build without any other files.
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Cipher suite findings</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #ccc; padding: 0.4em 0.6em; text-align: left; }
+th { background: #f4f4f4; }
+code { font-size: 0.95em; }
+.error { background: #fde2e1; }
+.warning { background: #fff4d6; }
+.note { background: #e8f1fb; }
+</style>
+</head>
+<body>
+<h1>Cipher suite findings</h1>
+<p>{{.Summary}}</p>
+{{- if .Findings}}
+<table>
+<thead>
+<tr><th>Severity</th><th>Cipher suite</th><th>Classification</th><th>Location</th><th>Reason</th></tr>
+</thead>
+<tbody>
+{{- range .Findings}}
+<tr class="{{.Severity}}"><td>{{.Severity}}</td><td><code>{{.CipherSuite}}</code></td><td>{{.Classification}}</td><td>{{.Location}}</td><td>{{.Reason}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+type htmlFinding struct {
+	Finding
+	Location string
+}
+
+// WriteHTML renders findings as a self-contained HTML page, most severe
+// first.
+func WriteHTML(w io.Writer, findings []Finding) error {
+	data := struct {
+		Summary  string
+		Findings []htmlFinding
+	}{
+		Summary: summary(findings),
+	}
+	for _, f := range bySeverity(findings) {
+		data.Findings = append(data.Findings, htmlFinding{Finding: f, Location: f.position()})
+	}
+	return htmlTemplate.Execute(w, data)
+}
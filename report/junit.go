@@ -0,0 +1,84 @@
+package report
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit renders findings as JUnit XML. Every finding becomes a test case,
+// grouped into a test suite per location, and findings with error or warning
+// severity are reported as failures.
+func WriteJUnit(w io.Writer, findings []Finding) error {
+	suites := junitTestSuites{Name: toolName}
+	index := make(map[string]int)
+
+	for _, f := range findings {
+		i, ok := index[f.Location]
+		if !ok {
+			i = len(suites.Suites)
+			index[f.Location] = i
+			suites.Suites = append(suites.Suites, junitTestSuite{Name: f.Location})
+		}
+		suite := &suites.Suites[i]
+
+		c := junitTestCase{
+			Name:      f.CipherSuite,
+			ClassName: f.Location,
+		}
+		switch f.Severity() {
+		case SeverityError, SeverityWarning:
+			c.Failure = &junitFailure{
+				Message: f.Message(),
+				Type:    f.Classification.String(),
+				Text:    f.Reason,
+			}
+			suite.Failures++
+			suites.Failures++
+		default:
+			c.SystemOut = f.Message()
+		}
+
+		suite.Cases = append(suite.Cases, c)
+		suite.Tests++
+		suites.Tests++
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
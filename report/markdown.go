@@ -0,0 +1,77 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// severities lists the severities from most to least severe.
+var severities = []Severity{SeverityError, SeverityWarning, SeverityNote, SeverityNone}
+
+func (s Severity) rank() int {
+	for i, severity := range severities {
+		if severity == s {
+			return i
+		}
+	}
+	return len(severities)
+}
+
+// bySeverity returns a copy of findings ordered from most to least severe,
+// preserving the original order of findings with the same severity.
+func bySeverity(findings []Finding) []Finding {
+	sorted := append([]Finding(nil), findings...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Severity().rank() < sorted[j].Severity().rank()
+	})
+	return sorted
+}
+
+// summary describes the number of findings at each severity.
+func summary(findings []Finding) string {
+	n := counts(findings)
+	return fmt.Sprintf("%s: %s, %s, %s",
+		plural(len(findings), "finding"),
+		plural(n[SeverityError], "error"),
+		plural(n[SeverityWarning], "warning"),
+		plural(n[SeverityNote], "note"))
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// WriteMarkdown renders findings as a Markdown table, most severe first,
+// suitable for posting as a pull request comment.
+func WriteMarkdown(w io.Writer, findings []Finding) error {
+	var b strings.Builder
+	b.WriteString("## Cipher suite findings\n\n")
+	b.WriteString(summary(findings) + "\n")
+
+	if len(findings) > 0 {
+		b.WriteString("\n| Severity | Cipher suite | Classification | Location | Reason |\n")
+		b.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, f := range bySeverity(findings) {
+			fmt.Fprintf(&b, "| %s | `%s` | %s | %s | %s |\n",
+				f.Severity(),
+				markdownEscape(f.CipherSuite),
+				f.Classification,
+				markdownEscape(f.position()),
+				markdownEscape(f.Reason),
+			)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
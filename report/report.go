@@ -0,0 +1,175 @@
+// Package report renders cipher suite findings in formats consumed by CI
+// systems and code review tools: SARIF 2.1.0, JUnit XML, Markdown and a
+// self-contained HTML summary.
+//
+// A [Finding] records a cipher suite observed at some location, such as a
+// scanned server or a packet capture, together with its classification. The
+// severity of a finding is derived from the classification, so Insecure
+// cipher suites are reported as errors and Weak cipher suites as warnings.
+package report
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/pcap"
+	"github.com/tomasbasham/ciphersuites/scan"
+)
+
+const (
+	toolName = "ciphersuites"
+	toolURI  = "https://github.com/tomasbasham/ciphersuites"
+)
+
+// Severity is the importance of a finding. The values match the result levels
+// defined by SARIF.
+type Severity string
+
+// Finding severities, from most to least severe.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+	SeverityNone    Severity = "none"
+)
+
+// SeverityOf maps a classification to the severity of a finding: Insecure
+// cipher suites are errors, Weak cipher suites are warnings and cipher suites
+// that cannot be classified are notes.
+func SeverityOf(c ciphersuites.Classification) Severity {
+	switch c {
+	case ciphersuites.Insecure:
+		return SeverityError
+	case ciphersuites.Weak:
+		return SeverityWarning
+	case ciphersuites.Unknown:
+		return SeverityNote
+	default:
+		return SeverityNone
+	}
+}
+
+// Finding records a cipher suite observed at a location.
+type Finding struct {
+	CipherSuite    string
+	Classification ciphersuites.Classification
+
+	// Reason describes how the cipher suite was observed, such as the protocol
+	// version it was accepted with.
+	Reason string
+
+	// Location identifies where the cipher suite was observed, such as a
+	// network address or a file path.
+	Location string
+
+	// Line is the line of Location the finding refers to, or zero if Location
+	// is not a text file.
+	Line int
+}
+
+// NewFinding classifies the named cipher suite observed at location.
+func NewFinding(name, location, reason string) Finding {
+	return Finding{
+		CipherSuite:    name,
+		Classification: ciphersuites.GetClassification(name),
+		Reason:         reason,
+		Location:       location,
+	}
+}
+
+// Severity returns the severity of the finding.
+func (f Finding) Severity() Severity {
+	return SeverityOf(f.Classification)
+}
+
+// Message describes the finding in a single sentence.
+func (f Finding) Message() string {
+	msg := fmt.Sprintf("%s is classified %s", f.CipherSuite, f.Classification)
+	if f.Reason != "" {
+		msg += ": " + f.Reason
+	}
+	return msg
+}
+
+// position formats the location and, if known, the line of the finding.
+func (f Finding) position() string {
+	if f.Line > 0 {
+		return fmt.Sprintf("%s:%d", f.Location, f.Line)
+	}
+	return f.Location
+}
+
+// FromScan returns a finding for every combination of protocol version and
+// cipher suite accepted by a scanned server.
+func FromScan(result *scan.Result) []Finding {
+	findings := make([]Finding, 0, len(result.Accepted))
+	for _, a := range result.Accepted {
+		findings = append(findings, Finding{
+			CipherSuite:    a.Name,
+			Classification: a.Classification,
+			Reason:         "accepted over " + ciphersuites.VersionName(a.Version),
+			Location:       result.Address,
+		})
+	}
+	return findings
+}
+
+// FromHandshakes returns a finding for every completed handshake read from
+// the packet capture at file.
+func FromHandshakes(file string, handshakes []pcap.Handshake) []Finding {
+	var findings []Finding
+	for _, h := range handshakes {
+		if !h.Complete() {
+			continue
+		}
+		reason := fmt.Sprintf("negotiated over %s between %s and %s",
+			ciphersuites.VersionName(h.Version()), hostPort(h.Client), hostPort(h.Server))
+		finding := Finding{
+			CipherSuite:    fmt.Sprintf("0x%04X", h.CipherSuite()),
+			Classification: ciphersuites.Unknown,
+			Reason:         reason,
+			Location:       file,
+		}
+		if cs, ok := ciphersuites.GetCipherSuiteByID(h.CipherSuite()); ok {
+			finding.CipherSuite = cs.Name
+			finding.Classification = cs.Classification
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+// Formats lists the names accepted by [Write].
+var Formats = []string{"sarif", "junit", "markdown", "html"}
+
+// Write renders findings in the named format.
+func Write(w io.Writer, format string, findings []Finding) error {
+	switch format {
+	case "sarif":
+		return WriteSARIF(w, findings)
+	case "junit":
+		return WriteJUnit(w, findings)
+	case "markdown":
+		return WriteMarkdown(w, findings)
+	case "html":
+		return WriteHTML(w, findings)
+	default:
+		return fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+// counts returns the number of findings at each severity.
+func counts(findings []Finding) map[Severity]int {
+	n := make(map[Severity]int)
+	for _, f := range findings {
+		n[f.Severity()]++
+	}
+	return n
+}
+
+func hostPort(addr net.TCPAddr) string {
+	return net.JoinHostPort(addr.IP.String(), strconv.Itoa(addr.Port))
+}
@@ -0,0 +1,224 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/pcap"
+	"github.com/tomasbasham/ciphersuites/report"
+)
+
+var findings = []report.Finding{
+	report.NewFinding("TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "example.test:443", "accepted over TLS1.2"),
+	report.NewFinding("TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA", "example.test:443", "accepted over TLS1.2"),
+	{
+		CipherSuite:    "TLS_RSA_WITH_RC4_128_SHA",
+		Classification: ciphersuites.Insecure,
+		Reason:         "configured <here>",
+		Location:       "nginx.conf",
+		Line:           12,
+	},
+}
+
+func TestSeverityOf(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		classification ciphersuites.Classification
+		want           report.Severity
+	}{
+		"insecure is an error":       {ciphersuites.Insecure, report.SeverityError},
+		"weak is a warning":          {ciphersuites.Weak, report.SeverityWarning},
+		"unknown is a note":          {ciphersuites.Unknown, report.SeverityNote},
+		"secure is not flagged":      {ciphersuites.Secure, report.SeverityNone},
+		"recommended is not flagged": {ciphersuites.Recommended, report.SeverityNone},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := report.SeverityOf(tt.classification); got != tt.want {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromHandshakes(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open("../pcap/testdata/handshakes.pcap")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+
+	handshakes, err := pcap.ReadHandshakes(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, f := range report.FromHandshakes("handshakes.pcap", handshakes) {
+		got = append(got, f.CipherSuite+" "+f.Classification.String())
+	}
+	want := []string{
+		"TLS_AES_128_GCM_SHA256 recommended",
+		"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA weak",
+		"TLS_ECDHE_RSA_WITH_RC4_128_SHA insecure",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, want)
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := report.WriteSARIF(&buf, findings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation *struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+					LogicalLocations []struct {
+						Name string `json:"name"`
+					} `json:"logicalLocations"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	if log.Version != "2.1.0" {
+		t.Errorf("mismatch:\n  got:  %q\n  want: %q", log.Version, "2.1.0")
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 2 {
+		t.Fatalf("expected one run with two results, got %s", buf.String())
+	}
+
+	weak, insecure := log.Runs[0].Results[0], log.Runs[0].Results[1]
+	if weak.RuleID != "weak-cipher-suite" || weak.Level != "warning" {
+		t.Errorf("mismatch:\n  got:  %s %s\n  want: weak-cipher-suite warning", weak.RuleID, weak.Level)
+	}
+	if name := weak.Locations[0].LogicalLocations[0].Name; name != "example.test:443" {
+		t.Errorf("mismatch:\n  got:  %q\n  want: %q", name, "example.test:443")
+	}
+	if insecure.RuleID != "insecure-cipher-suite" || insecure.Level != "error" {
+		t.Errorf("mismatch:\n  got:  %s %s\n  want: insecure-cipher-suite error", insecure.RuleID, insecure.Level)
+	}
+	if loc := insecure.Locations[0].PhysicalLocation; loc == nil || loc.ArtifactLocation.URI != "nginx.conf" || loc.Region.StartLine != 12 {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: nginx.conf line 12", loc)
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := report.WriteJUnit(&buf, findings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var suites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Type string `xml:"type,attr"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("invalid XML: %v", err)
+	}
+
+	if suites.Tests != 3 || suites.Failures != 2 {
+		t.Errorf("mismatch:\n  got:  %d tests, %d failures\n  want: 3 tests, 2 failures", suites.Tests, suites.Failures)
+	}
+	if len(suites.Suites) != 2 || suites.Suites[0].Name != "example.test:443" || suites.Suites[1].Name != "nginx.conf" {
+		t.Fatalf("expected a test suite per location, got %s", buf.String())
+	}
+	if c := suites.Suites[0].Cases[0]; c.Failure != nil {
+		t.Errorf("unexpected failure for %s", c.Name)
+	}
+	if c := suites.Suites[0].Cases[1]; c.Failure == nil || c.Failure.Type != "weak" {
+		t.Errorf("expected weak failure for %s", c.Name)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf, findings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		"3 findings: 1 error, 1 warning, 0 notes",
+		"| error | `TLS_RSA_WITH_RC4_128_SHA` | insecure | nginx.conf:12 |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "RC4") > strings.Index(got, "AES_128_CBC") {
+		t.Errorf("expected the most severe finding first:\n%s", got)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := report.WriteHTML(&buf, findings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		`<tr class="error">`,
+		"configured &lt;here&gt;",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	if err := report.Write(&bytes.Buffer{}, "pdf", findings); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
@@ -0,0 +1,174 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// sarifRules describes the rule reported for each severity.
+var sarifRules = []sarifRule{
+	{
+		ID:               "insecure-cipher-suite",
+		Name:             "InsecureCipherSuite",
+		ShortDescription: sarifMessage{Text: "Insecure cipher suite"},
+		FullDescription:  sarifMessage{Text: "The cipher suite is classified insecure and must not be used."},
+		DefaultConfiguration: sarifConfiguration{
+			Level: SeverityError,
+		},
+	},
+	{
+		ID:               "weak-cipher-suite",
+		Name:             "WeakCipherSuite",
+		ShortDescription: sarifMessage{Text: "Weak cipher suite"},
+		FullDescription:  sarifMessage{Text: "The cipher suite is classified weak and should be replaced with a recommended one."},
+		DefaultConfiguration: sarifConfiguration{
+			Level: SeverityWarning,
+		},
+	},
+	{
+		ID:               "unclassified-cipher-suite",
+		Name:             "UnclassifiedCipherSuite",
+		ShortDescription: sarifMessage{Text: "Unclassified cipher suite"},
+		FullDescription:  sarifMessage{Text: "The cipher suite does not appear in the classification dataset."},
+		DefaultConfiguration: sarifConfiguration{
+			Level: SeverityNote,
+		},
+	},
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	FullDescription      sarifMessage       `json:"fullDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level Severity `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     Severity        `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifLogicalLocation struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// WriteSARIF renders findings as a SARIF 2.1.0 log with a single run. Only
+// findings with a severity other than [SeverityNone] are included as results.
+//
+// Findings with a line number are reported at a physical location within the
+// file named by Location. Any other location, such as a network address, is
+// reported as a logical location.
+func WriteSARIF(w io.Writer, findings []Finding) error {
+	results := []sarifResult{}
+	for _, f := range findings {
+		index := sarifRuleIndex(f.Severity())
+		if index < 0 {
+			continue
+		}
+
+		result := sarifResult{
+			RuleID:    sarifRules[index].ID,
+			RuleIndex: index,
+			Level:     f.Severity(),
+			Message:   sarifMessage{Text: f.Message()},
+		}
+		switch {
+		case f.Location == "":
+		case f.Line > 0:
+			result.Locations = []sarifLocation{{
+				PhysicalLocation: &sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: f.Location},
+					Region:           sarifRegion{StartLine: f.Line},
+				},
+			}}
+		default:
+			result.Locations = []sarifLocation{{
+				LogicalLocations: []sarifLogicalLocation{{Name: f.Location, Kind: "resource"}},
+			}}
+		}
+		results = append(results, result)
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           toolName,
+				InformationURI: toolURI,
+				Rules:          sarifRules,
+			}},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+func sarifRuleIndex(s Severity) int {
+	for i, rule := range sarifRules {
+		if rule.DefaultConfiguration.Level == s {
+			return i
+		}
+	}
+	return -1
+}
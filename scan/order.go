@@ -0,0 +1,186 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// Preference describes how a server chooses between the cipher suites
+// offered by a client.
+type Preference string
+
+// Cipher suite preferences.
+const (
+	// ServerPreference means the server selects cipher suites according to
+	// its own order, regardless of the order offered by the client.
+	ServerPreference Preference = "server"
+	// ClientPreference means the server selects the first acceptable cipher
+	// suite offered by the client.
+	ClientPreference Preference = "client"
+	// Indeterminate means the server accepts fewer than two cipher suites at
+	// the version, so it never has to choose.
+	Indeterminate Preference = "indeterminate"
+)
+
+// Inversion records a Weak or Insecure cipher suite that a server prefers
+// over a Recommended one.
+type Inversion struct {
+	Preferred Accepted
+	Over      Accepted
+}
+
+func (i Inversion) String() string {
+	return fmt.Sprintf("%s %s is preferred over %s %s",
+		i.Preferred.Classification, i.Preferred.Name, i.Over.Classification, i.Over.Name)
+}
+
+// VersionOrder describes the cipher suite preference of a server at a single
+// protocol version.
+type VersionOrder struct {
+	Version    uint16
+	Preference Preference
+
+	// CipherSuites lists the accepted cipher suites. With server preference
+	// they are in the order the server prefers them, otherwise they are in
+	// the order reported by [Scan].
+	CipherSuites []Accepted
+
+	// Inversions lists, for every Weak or Insecure cipher suite the server
+	// prefers, the first Recommended cipher suite it is preferred over.
+	Inversions []Inversion
+}
+
+// OrderResult is the outcome of probing a server's cipher suite preference.
+type OrderResult struct {
+	Address  string
+	Versions []VersionOrder
+}
+
+// Inversions returns the inversions found at every version.
+func (r *OrderResult) Inversions() []Inversion {
+	var inversions []Inversion
+	for _, v := range r.Versions {
+		inversions = append(inversions, v.Inversions...)
+	}
+	return inversions
+}
+
+// Order determines whether the server at address enforces its own cipher
+// suite preference at each protocol version it accepts, and if so what that
+// preference is.
+//
+// The accepted cipher suites are first enumerated with [Scan]. They are then
+// offered together in opposite orders: a server that selects the same cipher
+// suite both times has a preference of its own, which is recovered by
+// repeatedly offering every cipher suite that has not yet been selected.
+func Order(ctx context.Context, address string, opts Options) (*OrderResult, error) {
+	scanned, err := Scan(ctx, address, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &OrderResult{Address: address}
+	for _, version := range scanned.Versions() {
+		var accepted []Accepted
+		for _, a := range scanned.Accepted {
+			if a.Version == version {
+				accepted = append(accepted, a)
+			}
+		}
+
+		order, err := versionOrder(ctx, address, version, accepted, opts)
+		if err != nil {
+			return nil, err
+		}
+		result.Versions = append(result.Versions, order)
+	}
+
+	return result, nil
+}
+
+func versionOrder(ctx context.Context, address string, version uint16, accepted []Accepted, opts Options) (VersionOrder, error) {
+	order := VersionOrder{
+		Version:      version,
+		Preference:   Indeterminate,
+		CipherSuites: accepted,
+	}
+	if len(accepted) < 2 {
+		return order, nil
+	}
+
+	reversed := make([]Accepted, len(accepted))
+	for i, a := range accepted {
+		reversed[len(accepted)-1-i] = a
+	}
+
+	forward, err := selectSuite(ctx, address, version, accepted, opts)
+	if err != nil {
+		return order, err
+	}
+	backward, err := selectSuite(ctx, address, version, reversed, opts)
+	if err != nil {
+		return order, err
+	}
+	if accepted[forward] != reversed[backward] {
+		order.Preference = ClientPreference
+		return order, nil
+	}
+
+	order.Preference = ServerPreference
+	order.CipherSuites = nil
+
+	remaining := append([]Accepted(nil), accepted...)
+	for len(remaining) > 1 {
+		i, err := selectSuite(ctx, address, version, remaining, opts)
+		if err != nil {
+			return order, err
+		}
+		order.CipherSuites = append(order.CipherSuites, remaining[i])
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+	order.CipherSuites = append(order.CipherSuites, remaining[0])
+
+	order.Inversions = inversions(order.CipherSuites)
+	return order, nil
+}
+
+// selectSuite offers suites in order and returns the index of the one the
+// server selects.
+func selectSuite(ctx context.Context, address string, version uint16, suites []Accepted, opts Options) (int, error) {
+	ids := make([]uint16, 0, len(suites))
+	for _, a := range suites {
+		ids = append(ids, a.CipherSuite)
+	}
+
+	hello, err := Offer(ctx, address, version, ids, opts)
+	if err != nil {
+		return 0, fmt.Errorf("offering %d cipher suites at %s: %w", len(ids), ciphersuites.VersionName(version), err)
+	}
+	if v := hello.NegotiatedVersion(); v != version {
+		return 0, fmt.Errorf("server negotiated %s when offered %s", ciphersuites.VersionName(v), ciphersuites.VersionName(version))
+	}
+	for i, id := range ids {
+		if id == hello.CipherSuite {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("server selected cipher suite 0x%04X, which was not offered", hello.CipherSuite)
+}
+
+func inversions(preferred []Accepted) []Inversion {
+	var found []Inversion
+	for i, a := range preferred {
+		if a.Classification != ciphersuites.Weak && a.Classification != ciphersuites.Insecure {
+			continue
+		}
+		for _, over := range preferred[i+1:] {
+			if over.Classification == ciphersuites.Recommended {
+				found = append(found, Inversion{Preferred: a, Over: over})
+				break
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,101 @@
+package scan_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites/internal/testcert"
+	"github.com/tomasbasham/ciphersuites/scan"
+)
+
+func TestOrder(t *testing.T) {
+	t.Parallel()
+
+	const (
+		gcm = tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256
+		cbc = tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA
+	)
+
+	var tests = map[string]struct {
+		choose         func(offered []uint16) uint16
+		wantPreference scan.Preference
+		wantOrder      []uint16
+		wantInversions int
+	}{
+		"detects server preference": {
+			wantPreference: scan.ServerPreference,
+			wantOrder:      []uint16{gcm, cbc},
+		},
+		"detects client preference": {
+			choose:         func(offered []uint16) uint16 { return offered[0] },
+			wantPreference: scan.ClientPreference,
+			wantOrder:      []uint16{cbc, gcm},
+		},
+		"flags weak suite preferred over recommended": {
+			choose: func(offered []uint16) uint16 {
+				for _, id := range offered {
+					if id == cbc {
+						return cbc
+					}
+				}
+				return offered[0]
+			},
+			wantPreference: scan.ServerPreference,
+			wantOrder:      []uint16{cbc, gcm},
+			wantInversions: 1,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &tls.Config{
+				Certificates: []tls.Certificate{testcert.ECDSA()},
+				MaxVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{gcm, cbc},
+			}
+			if tt.choose != nil {
+				config.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+					cfg := config.Clone()
+					cfg.GetConfigForClient = nil
+					cfg.CipherSuites = []uint16{tt.choose(hello.CipherSuites)}
+					return cfg, nil
+				}
+			}
+			address := serve(t, func(net.Conn) error { return nil }, config)
+
+			result, err := scan.Order(context.Background(), address, scan.Options{
+				Versions:     []uint16{tls.VersionTLS12},
+				CipherSuites: []uint16{cbc, gcm},
+				Timeout:      5 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result.Versions) != 1 {
+				t.Fatalf("mismatch:\n  got:  %d versions\n  want: 1", len(result.Versions))
+			}
+
+			order := result.Versions[0]
+			if order.Preference != tt.wantPreference {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", order.Preference, tt.wantPreference)
+			}
+
+			var got []uint16
+			for _, a := range order.CipherSuites {
+				got = append(got, a.CipherSuite)
+			}
+			if len(got) != len(tt.wantOrder) || got[0] != tt.wantOrder[0] || got[1] != tt.wantOrder[1] {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.wantOrder)
+			}
+
+			if len(result.Inversions()) != tt.wantInversions {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %d inversions", result.Inversions(), tt.wantInversions)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+// Package scan actively enumerates the protocol versions and cipher suites
+// accepted by a TLS server.
+//
+// Each probe opens a new connection and sends a hand-built ClientHello that
+// offers a single cipher suite at a single protocol version. The probe only
+// reads the server's reply far enough to see which parameters were selected,
+// so cipher suites can be tested without the client implementing them.
+package scan
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/handshake"
+)
+
+const (
+	defaultConcurrency = 8
+	defaultTimeout     = 5 * time.Second
+)
+
+// Key exchange groups and signature algorithms offered by every probe. They
+// are deliberately broad so that a rejection can be attributed to the cipher
+// suite or version rather than to another parameter.
+var (
+	supportedGroups = []uint16{
+		uint16(tls.X25519),
+		uint16(tls.CurveP256),
+		uint16(tls.CurveP384),
+		uint16(tls.CurveP521),
+	}
+
+	signatureAlgorithms = []uint16{
+		uint16(tls.ECDSAWithP256AndSHA256),
+		uint16(tls.ECDSAWithP384AndSHA384),
+		uint16(tls.ECDSAWithP521AndSHA512),
+		uint16(tls.Ed25519),
+		uint16(tls.PSSWithSHA256),
+		uint16(tls.PSSWithSHA384),
+		uint16(tls.PSSWithSHA512),
+		uint16(tls.PKCS1WithSHA256),
+		uint16(tls.PKCS1WithSHA384),
+		uint16(tls.PKCS1WithSHA512),
+		uint16(tls.ECDSAWithSHA1),
+		uint16(tls.PKCS1WithSHA1),
+	}
+)
+
+// Options configures a scan.
+type Options struct {
+	// ServerName is sent in the server name indication extension. It defaults
+	// to the host part of the address when that is not an IP address.
+	ServerName string
+
+	// Versions lists the protocol versions to probe. It defaults to TLS 1.0
+	// through TLS 1.3.
+	Versions []uint16
+
+	// CipherSuites lists the cipher suites to probe. It defaults to every
+	// cipher suite in the registry, other than the signalling cipher suite
+	// values, each probed only at the versions it can be negotiated with.
+	CipherSuites []uint16
+
+	// Concurrency limits the number of simultaneous connections. It defaults
+	// to eight.
+	Concurrency int
+
+	// Timeout bounds each probe, including dialing and any STARTTLS exchange.
+	// It defaults to five seconds.
+	Timeout time.Duration
+
+	// StartTLS selects a plaintext protocol to upgrade before the handshake.
+	StartTLS StartTLS
+
+	// Dial, if set, is used to open connections instead of a [net.Dialer].
+	Dial func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Accepted describes a cipher suite a server accepted at a protocol version.
+type Accepted struct {
+	Version        uint16
+	CipherSuite    uint16
+	Name           string
+	Classification ciphersuites.Classification
+}
+
+// Result is the outcome of scanning a server.
+type Result struct {
+	Address  string
+	Accepted []Accepted
+
+	// Classification is the weakest classification among the accepted cipher
+	// suites, or [ciphersuites.Unknown] if nothing was accepted.
+	Classification ciphersuites.Classification
+}
+
+// Versions returns the protocol versions the server accepted, newest first.
+func (r *Result) Versions() []uint16 {
+	var versions []uint16
+	for _, a := range r.Accepted {
+		if len(versions) == 0 || versions[len(versions)-1] != a.Version {
+			versions = append(versions, a.Version)
+		}
+	}
+	return versions
+}
+
+type probe struct {
+	version     uint16
+	cipherSuite uint16
+}
+
+// Scan probes the server at address with every combination of version and
+// cipher suite in opts. It returns an error if the server could not be
+// reached, as opposed to rejecting an offer.
+func Scan(ctx context.Context, address string, opts Options) (*Result, error) {
+	opts = opts.withDefaults(address)
+	probes := opts.probes()
+
+	var (
+		mu       sync.Mutex
+		accepted []Accepted
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, opts.Concurrency)
+	for _, p := range probes {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(p probe) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			ok, err := accepts(ctx, address, p, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if ok {
+				a := Accepted{
+					Version:        p.version,
+					CipherSuite:    p.cipherSuite,
+					Name:           fmt.Sprintf("0x%04X", p.cipherSuite),
+					Classification: ciphersuites.Unknown,
+				}
+				if cs, ok := ciphersuites.GetCipherSuiteByID(p.cipherSuite); ok {
+					a.Name = cs.Name
+					a.Classification = cs.Classification
+				}
+				accepted = append(accepted, a)
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.Slice(accepted, func(i, j int) bool {
+		if accepted[i].Version != accepted[j].Version {
+			return accepted[i].Version > accepted[j].Version
+		}
+		return accepted[i].CipherSuite < accepted[j].CipherSuite
+	})
+
+	result := &Result{Address: address, Accepted: accepted}
+	for i, a := range accepted {
+		if i == 0 || !a.Classification.AtLeast(result.Classification) {
+			result.Classification = a.Classification
+		}
+	}
+
+	return result, nil
+}
+
+// accepts reports whether the server selects the probed cipher suite and
+// version when they are the only ones offered.
+func accepts(ctx context.Context, address string, p probe, opts Options) (bool, error) {
+	hello, err := Offer(ctx, address, p.version, []uint16{p.cipherSuite}, opts)
+	if err != nil {
+		var rejected *RejectedError
+		if errors.As(err, &rejected) {
+			return false, nil
+		}
+		return false, err
+	}
+	return hello.CipherSuite == p.cipherSuite && hello.NegotiatedVersion() == p.version, nil
+}
+
+// RejectedError is returned by [Offer] when the server refuses the offer,
+// whether by sending an alert or by closing the connection.
+type RejectedError struct {
+	Err error
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("offer rejected: %v", e.Err)
+}
+
+func (e *RejectedError) Unwrap() error {
+	return e.Err
+}
+
+// Offer connects to the server at address, offers the cipher suites in order
+// of preference at the given protocol version, and returns the server's
+// reply. The connection is closed as soon as the ServerHello is received.
+//
+// A [*RejectedError] is returned if the server refuses the offer. Any other
+// error, including a timeout waiting for the reply, indicates that the server
+// could not be reached.
+func Offer(ctx context.Context, address string, version uint16, suites []uint16, opts Options) (*handshake.ServerHello, error) {
+	opts = opts.withDefaults(address)
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	conn, err := opts.Dial(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := opts.StartTLS.negotiate(conn); err != nil {
+		return nil, err
+	}
+
+	hello, err := clientHello(opts.ServerName, version, suites)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(hello.Marshal().Record()); err != nil {
+		return nil, rejected(err)
+	}
+
+	serverHello, err := handshake.ReadServerHello(conn)
+	if err != nil {
+		return nil, rejected(err)
+	}
+
+	return serverHello, nil
+}
+
+// rejected wraps an error exchanging hellos with the server in a
+// [*RejectedError]. Servers commonly close the connection rather than sending
+// an alert when they cannot agree on parameters, but a server that does not
+// reply before the deadline has not refused the offer, so timeouts are
+// returned unwrapped.
+func rejected(err error) error {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return err
+	}
+	return &RejectedError{Err: err}
+}
+
+func clientHello(serverName string, version uint16, suites []uint16) (*handshake.ClientHello, error) {
+	random := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, random); err != nil {
+		return nil, err
+	}
+
+	hello := &handshake.ClientHello{
+		Version:             version,
+		Random:              random,
+		CipherSuites:        suites,
+		ServerName:          serverName,
+		SupportedGroups:     supportedGroups,
+		SignatureAlgorithms: signatureAlgorithms,
+	}
+
+	if version >= tls.VersionTLS13 {
+		key, err := ecdh.X25519().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		// TLS 1.3 hellos masquerade as TLS 1.2 for middlebox compatibility.
+		sessionID := make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, sessionID); err != nil {
+			return nil, err
+		}
+
+		hello.Version = tls.VersionTLS12
+		hello.SessionID = sessionID
+		hello.SupportedVersions = []uint16{version}
+		hello.KeyShares = []handshake.KeyShare{{
+			Group: uint16(tls.X25519),
+			Data:  key.PublicKey().Bytes(),
+		}}
+	}
+
+	return hello, nil
+}
+
+func (o Options) withDefaults(address string) Options {
+	if o.ServerName == "" {
+		if host, _, err := net.SplitHostPort(address); err == nil && net.ParseIP(host) == nil {
+			o.ServerName = host
+		}
+	}
+	if len(o.Versions) == 0 {
+		o.Versions = []uint16{tls.VersionTLS13, tls.VersionTLS12, tls.VersionTLS11, tls.VersionTLS10}
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultConcurrency
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = defaultTimeout
+	}
+	if o.Dial == nil {
+		var d net.Dialer
+		o.Dial = d.DialContext
+	}
+	return o
+}
+
+// probes lists every combination of version and cipher suite to offer. Known
+// cipher suites are only offered at the versions they can be negotiated with.
+func (o Options) probes() []probe {
+	suites := o.CipherSuites
+	if len(suites) == 0 {
+		for _, cs := range ciphersuites.CipherSuites() {
+			id, ok := ciphersuites.CipherSuiteID(cs.Name)
+			if ok && !strings.HasSuffix(cs.Name, "_SCSV") {
+				suites = append(suites, id)
+			}
+		}
+	}
+
+	var probes []probe
+	for _, version := range o.Versions {
+		for _, suite := range suites {
+			if cs, ok := ciphersuites.GetCipherSuiteByID(suite); ok && !cs.NegotiableAt(version) {
+				continue
+			}
+			probes = append(probes, probe{version: version, cipherSuite: suite})
+		}
+	}
+	return probes
+}
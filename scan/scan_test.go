@@ -0,0 +1,304 @@
+package scan_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/handshake"
+	"github.com/tomasbasham/ciphersuites/internal/testcert"
+	"github.com/tomasbasham/ciphersuites/scan"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		startTLS scan.StartTLS
+		preface  func(conn net.Conn) error
+	}{
+		"scans direct tls": {
+			startTLS: scan.StartTLSNone,
+			preface:  func(net.Conn) error { return nil },
+		},
+		"scans smtp starttls": {
+			startTLS: scan.StartTLSSMTP,
+			preface:  smtpPreface,
+		},
+		"scans imap starttls": {
+			startTLS: scan.StartTLSIMAP,
+			preface:  imapPreface,
+		},
+		"scans postgres starttls": {
+			startTLS: scan.StartTLSPostgres,
+			preface:  postgresPreface,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			address := serve(t, tt.preface, &tls.Config{
+				Certificates: []tls.Certificate{testcert.ECDSA()},
+				MinVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{
+					tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+					tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
+				},
+			})
+
+			result, err := scan.Scan(context.Background(), address, scan.Options{
+				StartTLS:    tt.startTLS,
+				Concurrency: 4,
+				Timeout:     5 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			want := []scan.Accepted{
+				{tls.VersionTLS13, tls.TLS_AES_128_GCM_SHA256, "TLS_AES_128_GCM_SHA256", ciphersuites.Recommended},
+				{tls.VersionTLS13, tls.TLS_AES_256_GCM_SHA384, "TLS_AES_256_GCM_SHA384", ciphersuites.Recommended},
+				{tls.VersionTLS13, tls.TLS_CHACHA20_POLY1305_SHA256, "TLS_CHACHA20_POLY1305_SHA256", ciphersuites.Recommended},
+				{tls.VersionTLS12, tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA, "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA", ciphersuites.Weak},
+				{tls.VersionTLS12, tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", ciphersuites.Recommended},
+			}
+			if len(result.Accepted) != len(want) {
+				t.Fatalf("mismatch:\n  got:  %+v\n  want: %+v", result.Accepted, want)
+			}
+			for i := range want {
+				if result.Accepted[i] != want[i] {
+					t.Errorf("mismatch at %d:\n  got:  %+v\n  want: %+v", i, result.Accepted[i], want[i])
+				}
+			}
+
+			if result.Classification != ciphersuites.Weak {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", result.Classification, ciphersuites.Weak)
+			}
+			if got := result.Versions(); len(got) != 2 || got[0] != tls.VersionTLS13 || got[1] != tls.VersionTLS12 {
+				t.Errorf("mismatch:\n  got:  %v\n  want: [TLS1.3 TLS1.2]", got)
+			}
+		})
+	}
+}
+
+func TestScanCipherSuiteUnknownToCryptoTLS(t *testing.T) {
+	t.Parallel()
+
+	// TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA is implemented by neither crypto/tls
+	// nor OpenSSL 3, so the server selects it by hand.
+	const suite = 0x0016
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+				if offersCipherSuite(conn, suite) {
+					conn.Write(serverHello(tls.VersionTLS12, suite))
+				}
+			}()
+		}
+	}()
+
+	result, err := scan.Scan(context.Background(), l.Addr().String(), scan.Options{
+		Versions:    []uint16{tls.VersionTLS12},
+		Concurrency: 8,
+		Timeout:     5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := scan.Accepted{tls.VersionTLS12, suite, "TLS_DHE_RSA_WITH_3DES_EDE_CBC_SHA", ciphersuites.Insecure}
+	if len(result.Accepted) != 1 || result.Accepted[0] != want {
+		t.Errorf("mismatch:\n  got:  %+v\n  want: [%+v]", result.Accepted, want)
+	}
+}
+
+func TestScanUnreachable(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := l.Addr().String()
+	l.Close()
+
+	if _, err := scan.Scan(context.Background(), address, scan.Options{Timeout: time.Second}); err == nil {
+		t.Error("expected error scanning closed port")
+	}
+}
+
+func TestOfferTimeout(t *testing.T) {
+	t.Parallel()
+
+	// The server accepts the connection but never replies to the hello.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(io.Discard, conn)
+			}()
+		}
+	}()
+
+	_, err = scan.Offer(context.Background(), l.Addr().String(), tls.VersionTLS12,
+		[]uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, scan.Options{Timeout: 100 * time.Millisecond})
+
+	var rejected *scan.RejectedError
+	if errors.As(err, &rejected) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: a timeout, not a rejection", err)
+	}
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Errorf("mismatch:\n  got:  %v\n  want: a timeout", err)
+	}
+}
+
+func TestScanCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := scan.Scan(ctx, "127.0.0.1:1", scan.Options{}); err != context.Canceled {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", err, context.Canceled)
+	}
+}
+
+// serve starts a TLS server that runs preface on every connection before the
+// handshake, returning its address.
+func serve(t *testing.T, preface func(net.Conn) error, config *tls.Config) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+				if err := preface(conn); err != nil {
+					return
+				}
+				_ = tls.Server(conn, config).Handshake()
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+// offersCipherSuite reads a ClientHello from conn and reports whether it
+// offers suite.
+func offersCipherSuite(conn net.Conn, suite uint16) bool {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return false
+	}
+	record := make([]byte, 5+(int(header[3])<<8|int(header[4])))
+	copy(record, header)
+	if _, err := io.ReadFull(conn, record[5:]); err != nil {
+		return false
+	}
+
+	messages, err := handshake.ReadMessages(record)
+	if err != nil || len(messages) == 0 {
+		return false
+	}
+	hello, err := handshake.ParseClientHello(messages[0])
+	if err != nil {
+		return false
+	}
+	for _, s := range hello.CipherSuites {
+		if s == suite {
+			return true
+		}
+	}
+	return false
+}
+
+// serverHello returns a record holding a ServerHello that selects suite at
+// version, which must be TLS 1.2 or earlier.
+func serverHello(version, suite uint16) []byte {
+	body := []byte{byte(version >> 8), byte(version)}
+	body = append(body, make([]byte, 32)...) // random
+	body = append(body, 0)                   // session ID
+	body = append(body, byte(suite>>8), byte(suite), 0)
+
+	message := append([]byte{handshake.TypeServerHello, 0, byte(len(body) >> 8), byte(len(body))}, body...)
+	return append([]byte{22, 3, 3, byte(len(message) >> 8), byte(len(message))}, message...)
+}
+
+func smtpPreface(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	io.WriteString(conn, "220 mail.example.test ESMTP\r\n")
+	if _, err := r.ReadString('\n'); err != nil {
+		return err
+	}
+	io.WriteString(conn, "250-mail.example.test\r\n250 STARTTLS\r\n")
+	line, err := r.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "STARTTLS") {
+		return io.ErrUnexpectedEOF
+	}
+	_, err = io.WriteString(conn, "220 Ready to start TLS\r\n")
+	return err
+}
+
+func imapPreface(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	io.WriteString(conn, "* OK IMAP4rev1 ready\r\n")
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	tag := strings.Fields(line)[0]
+	_, err = io.WriteString(conn, tag+" OK Begin TLS negotiation now\r\n")
+	return err
+}
+
+func postgresPreface(conn net.Conn) error {
+	request := make([]byte, 8)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return err
+	}
+	_, err := conn.Write([]byte{'S'})
+	return err
+}
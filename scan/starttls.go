@@ -0,0 +1,130 @@
+package scan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// StartTLS identifies an application protocol that must be negotiated in
+// plaintext before the TLS handshake can begin.
+type StartTLS string
+
+// Supported STARTTLS protocols.
+const (
+	StartTLSNone     StartTLS = ""
+	StartTLSSMTP     StartTLS = "smtp"
+	StartTLSIMAP     StartTLS = "imap"
+	StartTLSPostgres StartTLS = "postgres"
+)
+
+// ParseStartTLS parses a STARTTLS protocol name.
+func ParseStartTLS(name string) (StartTLS, error) {
+	switch p := StartTLS(strings.ToLower(name)); p {
+	case StartTLSNone, StartTLSSMTP, StartTLSIMAP, StartTLSPostgres:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unsupported STARTTLS protocol %q", name)
+	}
+}
+
+// negotiate performs the plaintext exchange that precedes the TLS handshake.
+func (p StartTLS) negotiate(conn net.Conn) error {
+	switch p {
+	case StartTLSNone:
+		return nil
+	case StartTLSSMTP:
+		return startSMTP(conn)
+	case StartTLSIMAP:
+		return startIMAP(conn)
+	case StartTLSPostgres:
+		return startPostgres(conn)
+	default:
+		return fmt.Errorf("unsupported STARTTLS protocol %q", string(p))
+	}
+}
+
+func startSMTP(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	if err := smtpReply(r, "220"); err != nil {
+		return fmt.Errorf("smtp greeting: %w", err)
+	}
+	if _, err := io.WriteString(conn, "EHLO ciphersuites.invalid\r\n"); err != nil {
+		return err
+	}
+	if err := smtpReply(r, "250"); err != nil {
+		return fmt.Errorf("smtp EHLO: %w", err)
+	}
+	if _, err := io.WriteString(conn, "STARTTLS\r\n"); err != nil {
+		return err
+	}
+	if err := smtpReply(r, "220"); err != nil {
+		return fmt.Errorf("smtp STARTTLS: %w", err)
+	}
+	return nil
+}
+
+// smtpReply reads a possibly multi-line SMTP reply and checks its code.
+func smtpReply(r *bufio.Reader, code string) error {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		if len(line) < 4 || line[:3] != code {
+			return fmt.Errorf("unexpected reply %q", strings.TrimSpace(line))
+		}
+		// A hyphen after the code marks a continuation line.
+		if line[3] != '-' {
+			return nil
+		}
+	}
+}
+
+func startIMAP(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("imap greeting: %w", err)
+	}
+	if !strings.HasPrefix(line, "* OK") {
+		return fmt.Errorf("imap greeting: unexpected response %q", strings.TrimSpace(line))
+	}
+	if _, err := io.WriteString(conn, "a001 STARTTLS\r\n"); err != nil {
+		return err
+	}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("imap STARTTLS: %w", err)
+		}
+		// Skip untagged responses until the tagged completion arrives.
+		if strings.HasPrefix(line, "* ") {
+			continue
+		}
+		if !strings.HasPrefix(line, "a001 OK") {
+			return fmt.Errorf("imap STARTTLS: unexpected response %q", strings.TrimSpace(line))
+		}
+		return nil
+	}
+}
+
+// postgresSSLRequest is the SSLRequest message: a length of eight followed
+// by the request code 80877103.
+var postgresSSLRequest = []byte{0x00, 0x00, 0x00, 0x08, 0x04, 0xD2, 0x16, 0x2F}
+
+func startPostgres(conn net.Conn) error {
+	if _, err := conn.Write(postgresSSLRequest); err != nil {
+		return err
+	}
+	response := make([]byte, 1)
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return fmt.Errorf("postgres SSLRequest: %w", err)
+	}
+	if response[0] != 'S' {
+		return fmt.Errorf("postgres SSLRequest: server does not support TLS")
+	}
+	return nil
+}
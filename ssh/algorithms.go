@@ -0,0 +1,164 @@
+package ssh
+
+import "github.com/tomasbasham/ciphersuites"
+
+// rating is the classification of an algorithm and the reason for it.
+type rating struct {
+	classification ciphersuites.Classification
+	reason         string
+}
+
+const (
+	recommended = ciphersuites.Recommended
+	secure      = ciphersuites.Secure
+	weak        = ciphersuites.Weak
+	insecure    = ciphersuites.Insecure
+)
+
+// ratings classifies the algorithms of each category, keyed by their names in
+// the IANA Secure Shell (SSH) Protocol Parameters registries or, for
+// algorithms only implemented by OpenSSH, by their OpenSSH names. Names ending
+// in "*" match any name with that prefix, as used by the GSS-API key exchange
+// methods whose names end with an encoded mechanism OID.
+//
+// As for certificates in [ciphersuites.ClassifySignatureAlgorithm] and
+// [ciphersuites.ClassifyPublicKey], signatures using SHA-1 and RSA keys
+// shorter than 2048 bits are insecure. SHA-1 is only weak where collisions do
+// not matter, in HMAC and in the exchange hash of a key exchange.
+var ratings = map[Category]map[string]rating{
+	KeyExchange: {
+		"mlkem768x25519-sha256":                {recommended, "hybrid post-quantum key exchange using ML-KEM-768 and X25519"},
+		"sntrup761x25519-sha512":               {recommended, "hybrid post-quantum key exchange using Streamlined NTRU Prime and X25519"},
+		"sntrup761x25519-sha512@openssh.com":   {recommended, "hybrid post-quantum key exchange using Streamlined NTRU Prime and X25519"},
+		"curve25519-sha256":                    {recommended, "X25519 key exchange; RFC 9142 says it SHOULD be implemented"},
+		"curve25519-sha256@libssh.org":         {recommended, "X25519 key exchange, under its name before standardisation"},
+		"curve448-sha512":                      {secure, "X448 key exchange"},
+		"ecdh-sha2-nistp256":                   {recommended, "ECDH key exchange on P-256; RFC 9142 says it SHOULD be implemented"},
+		"ecdh-sha2-nistp384":                   {recommended, "ECDH key exchange on P-384"},
+		"ecdh-sha2-nistp521":                   {recommended, "ECDH key exchange on P-521"},
+		"diffie-hellman-group14-sha256":        {recommended, "2048-bit MODP group with SHA-256; RFC 9142 says it MUST be implemented"},
+		"diffie-hellman-group15-sha512":        {secure, "3072-bit MODP group with SHA-512"},
+		"diffie-hellman-group16-sha512":        {recommended, "4096-bit MODP group with SHA-512; RFC 9142 says it SHOULD be implemented"},
+		"diffie-hellman-group17-sha512":        {secure, "6144-bit MODP group with SHA-512"},
+		"diffie-hellman-group18-sha512":        {secure, "8192-bit MODP group with SHA-512"},
+		"diffie-hellman-group-exchange-sha256": {secure, "server-chosen MODP group with SHA-256"},
+		"rsa2048-sha256":                       {secure, "RSA key transport with a 2048-bit key and SHA-256"},
+		"diffie-hellman-group14-sha1":          {weak, "2048-bit MODP group with SHA-1; RFC 9142 only permits it for compatibility"},
+		"diffie-hellman-group-exchange-sha1":   {weak, "server-chosen MODP group with SHA-1; RFC 9142 says it SHOULD NOT be implemented"},
+		"ecmqv-sha2":                           {weak, "ECMQV key exchange; RFC 9142 says it SHOULD NOT be implemented"},
+		"diffie-hellman-group1-sha1":           {insecure, "1024-bit MODP group with SHA-1, within reach of precomputation attacks such as Logjam"},
+		"rsa1024-sha1":                         {insecure, "RSA key transport with a 1024-bit key and SHA-1; RFC 9142 says it MUST NOT be implemented"},
+		"gss-curve25519-sha256-*":              {recommended, "GSS-API authenticated X25519 key exchange"},
+		"gss-nistp256-sha256-*":                {recommended, "GSS-API authenticated ECDH key exchange on P-256"},
+		"gss-nistp384-sha384-*":                {recommended, "GSS-API authenticated ECDH key exchange on P-384"},
+		"gss-nistp521-sha512-*":                {recommended, "GSS-API authenticated ECDH key exchange on P-521"},
+		"gss-curve448-sha512-*":                {secure, "GSS-API authenticated X448 key exchange"},
+		"gss-group14-sha256-*":                 {recommended, "GSS-API authenticated 2048-bit MODP group with SHA-256"},
+		"gss-group15-sha512-*":                 {secure, "GSS-API authenticated 3072-bit MODP group with SHA-512"},
+		"gss-group16-sha512-*":                 {recommended, "GSS-API authenticated 4096-bit MODP group with SHA-512"},
+		"gss-group17-sha512-*":                 {secure, "GSS-API authenticated 6144-bit MODP group with SHA-512"},
+		"gss-group18-sha512-*":                 {secure, "GSS-API authenticated 8192-bit MODP group with SHA-512"},
+		"gss-group14-sha1-*":                   {weak, "GSS-API authenticated 2048-bit MODP group with SHA-1"},
+		"gss-gex-sha1-*":                       {weak, "GSS-API authenticated server-chosen MODP group with SHA-1"},
+		"gss-group1-sha1-*":                    {insecure, "GSS-API authenticated 1024-bit MODP group with SHA-1"},
+	},
+	HostKey: {
+		"ssh-ed25519":                                 {recommended, "Ed25519 signatures"},
+		"ssh-ed25519-cert-v01@openssh.com":            {recommended, "Ed25519 signatures"},
+		"sk-ssh-ed25519@openssh.com":                  {recommended, "Ed25519 signatures from a security key"},
+		"sk-ssh-ed25519-cert-v01@openssh.com":         {recommended, "Ed25519 signatures from a security key"},
+		"ssh-ed448":                                   {recommended, "Ed448 signatures"},
+		"ecdsa-sha2-nistp256":                         {recommended, "ECDSA signatures on P-256"},
+		"ecdsa-sha2-nistp256-cert-v01@openssh.com":    {recommended, "ECDSA signatures on P-256"},
+		"ecdsa-sha2-nistp384":                         {recommended, "ECDSA signatures on P-384"},
+		"ecdsa-sha2-nistp384-cert-v01@openssh.com":    {recommended, "ECDSA signatures on P-384"},
+		"ecdsa-sha2-nistp521":                         {recommended, "ECDSA signatures on P-521"},
+		"ecdsa-sha2-nistp521-cert-v01@openssh.com":    {recommended, "ECDSA signatures on P-521"},
+		"sk-ecdsa-sha2-nistp256@openssh.com":          {recommended, "ECDSA signatures on P-256 from a security key"},
+		"sk-ecdsa-sha2-nistp256-cert-v01@openssh.com": {recommended, "ECDSA signatures on P-256 from a security key"},
+		"webauthn-sk-ecdsa-sha2-nistp256@openssh.com": {recommended, "ECDSA signatures on P-256 from a WebAuthn security key"},
+		"rsa-sha2-256":                                {secure, "RSA signatures with SHA-256, as strong as the RSA key"},
+		"rsa-sha2-256-cert-v01@openssh.com":           {secure, "RSA signatures with SHA-256, as strong as the RSA key"},
+		"rsa-sha2-512":                                {secure, "RSA signatures with SHA-512, as strong as the RSA key"},
+		"rsa-sha2-512-cert-v01@openssh.com":           {secure, "RSA signatures with SHA-512, as strong as the RSA key"},
+		"ssh-rsa":                                     {insecure, "RSA signatures with SHA-1, forgeable using chosen-prefix collisions and disabled by default since OpenSSH 8.8"},
+		"ssh-rsa-cert-v01@openssh.com":                {insecure, "RSA signatures with SHA-1, forgeable using chosen-prefix collisions and disabled by default since OpenSSH 8.8"},
+		"ssh-dss":                                     {insecure, "1024-bit DSA signatures with SHA-1, disabled by default since OpenSSH 7.0"},
+		"ssh-dss-cert-v01@openssh.com":                {insecure, "1024-bit DSA signatures with SHA-1, disabled by default since OpenSSH 7.0"},
+	},
+	Cipher: {
+		"chacha20-poly1305@openssh.com": {recommended, "ChaCha20-Poly1305 authenticated encryption"},
+		"aes128-gcm@openssh.com":        {recommended, "AES-128 in GCM mode, providing authenticated encryption"},
+		"aes256-gcm@openssh.com":        {recommended, "AES-256 in GCM mode, providing authenticated encryption"},
+		"AEAD_AES_128_GCM":              {recommended, "AES-128 in GCM mode, providing authenticated encryption"},
+		"AEAD_AES_256_GCM":              {recommended, "AES-256 in GCM mode, providing authenticated encryption"},
+		"aes128-ctr":                    {secure, "AES-128 in counter mode, relying on a separate MAC"},
+		"aes192-ctr":                    {secure, "AES-192 in counter mode, relying on a separate MAC"},
+		"aes256-ctr":                    {secure, "AES-256 in counter mode, relying on a separate MAC"},
+		"twofish128-ctr":                {secure, "Twofish in counter mode, relying on a separate MAC"},
+		"twofish192-ctr":                {secure, "Twofish in counter mode, relying on a separate MAC"},
+		"twofish256-ctr":                {secure, "Twofish in counter mode, relying on a separate MAC"},
+		"serpent128-ctr":                {secure, "Serpent in counter mode, relying on a separate MAC"},
+		"serpent192-ctr":                {secure, "Serpent in counter mode, relying on a separate MAC"},
+		"serpent256-ctr":                {secure, "Serpent in counter mode, relying on a separate MAC"},
+		"aes128-cbc":                    {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"aes192-cbc":                    {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"aes256-cbc":                    {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"rijndael-cbc@lysator.liu.se":   {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"twofish-cbc":                   {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"twofish128-cbc":                {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"twofish192-cbc":                {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"twofish256-cbc":                {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"serpent128-cbc":                {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"serpent192-cbc":                {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"serpent256-cbc":                {weak, "CBC mode is open to plaintext recovery (CVE-2008-5161)"},
+		"3des-cbc":                      {weak, "3DES has a 64-bit block and provides at most 112 bits of security"},
+		"3des-ctr":                      {weak, "3DES has a 64-bit block and provides at most 112 bits of security"},
+		"blowfish-cbc":                  {weak, "Blowfish has a 64-bit block"},
+		"blowfish-ctr":                  {weak, "Blowfish has a 64-bit block"},
+		"cast128-cbc":                   {weak, "CAST-128 has a 64-bit block"},
+		"cast128-ctr":                   {weak, "CAST-128 has a 64-bit block"},
+		"idea-cbc":                      {weak, "IDEA has a 64-bit block"},
+		"idea-ctr":                      {weak, "IDEA has a 64-bit block"},
+		"des-cbc":                       {insecure, "DES has a 56-bit key"},
+		"arcfour":                       {insecure, "RC4 is prohibited by RFC 8758"},
+		"arcfour128":                    {insecure, "RC4 is prohibited by RFC 8758"},
+		"arcfour256":                    {insecure, "RC4 is prohibited by RFC 8758"},
+		"none":                          {insecure, "no encryption"},
+	},
+	MAC: {
+		"hmac-sha2-256-etm@openssh.com":  {recommended, "HMAC-SHA-256 computed over the ciphertext (encrypt-then-MAC)"},
+		"hmac-sha2-512-etm@openssh.com":  {recommended, "HMAC-SHA-512 computed over the ciphertext (encrypt-then-MAC)"},
+		"umac-128-etm@openssh.com":       {recommended, "UMAC with a 128-bit tag computed over the ciphertext (encrypt-then-MAC)"},
+		"AEAD_AES_128_GCM":               {recommended, "authenticated by AES-128 in GCM mode"},
+		"AEAD_AES_256_GCM":               {recommended, "authenticated by AES-256 in GCM mode"},
+		"hmac-sha2-256":                  {secure, "HMAC-SHA-256 computed over the plaintext (encrypt-and-MAC)"},
+		"hmac-sha2-512":                  {secure, "HMAC-SHA-512 computed over the plaintext (encrypt-and-MAC)"},
+		"umac-128@openssh.com":           {secure, "UMAC with a 128-bit tag computed over the plaintext (encrypt-and-MAC)"},
+		"hmac-sha1":                      {weak, "HMAC-SHA-1"},
+		"hmac-sha1-etm@openssh.com":      {weak, "HMAC-SHA-1"},
+		"hmac-sha1-96":                   {weak, "HMAC-SHA-1 truncated to 96 bits"},
+		"hmac-sha1-96-etm@openssh.com":   {weak, "HMAC-SHA-1 truncated to 96 bits"},
+		"umac-64@openssh.com":            {weak, "UMAC with a 64-bit tag"},
+		"umac-64-etm@openssh.com":        {weak, "UMAC with a 64-bit tag"},
+		"hmac-ripemd160":                 {weak, "HMAC-RIPEMD-160, no longer supported by OpenSSH"},
+		"hmac-ripemd160@openssh.com":     {weak, "HMAC-RIPEMD-160, no longer supported by OpenSSH"},
+		"hmac-ripemd160-etm@openssh.com": {weak, "HMAC-RIPEMD-160, no longer supported by OpenSSH"},
+		"hmac-md5":                       {insecure, "HMAC-MD5"},
+		"hmac-md5-96":                    {insecure, "HMAC-MD5 truncated to 96 bits"},
+		"hmac-md5-etm@openssh.com":       {insecure, "HMAC-MD5"},
+		"hmac-md5-96-etm@openssh.com":    {insecure, "HMAC-MD5 truncated to 96 bits"},
+		"none":                           {insecure, "no integrity protection"},
+	},
+}
+
+// pseudoAlgorithms are names sent in the key exchange algorithm list to
+// signal support for protocol extensions. They do not name a key exchange
+// method and are not classified.
+var pseudoAlgorithms = map[string]bool{
+	"ext-info-c":                   true,
+	"ext-info-s":                   true,
+	"ext-info-in-auth@openssh.com": true,
+	"kex-strict-c-v00@openssh.com": true,
+	"kex-strict-s-v00@openssh.com": true,
+}
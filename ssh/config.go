@@ -0,0 +1,220 @@
+package ssh
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// DefaultsVersion is the release of OpenSSH whose defaults [AuditConfig]
+// assumes for algorithms a configuration does not set.
+const DefaultsVersion = "OpenSSH 9.2"
+
+// defaults are the algorithms [DefaultsVersion] enables when a configuration
+// does not set them, in order of preference. Strict key exchange was only
+// added in OpenSSH 9.6, so the defaults do not include it.
+var defaults = map[Category][]string{
+	KeyExchange: {
+		"sntrup761x25519-sha512@openssh.com",
+		"curve25519-sha256",
+		"curve25519-sha256@libssh.org",
+		"ecdh-sha2-nistp256",
+		"ecdh-sha2-nistp384",
+		"ecdh-sha2-nistp521",
+		"diffie-hellman-group-exchange-sha256",
+		"diffie-hellman-group16-sha512",
+		"diffie-hellman-group18-sha512",
+		"diffie-hellman-group14-sha256",
+	},
+	HostKey: {
+		"ssh-ed25519-cert-v01@openssh.com",
+		"ecdsa-sha2-nistp256-cert-v01@openssh.com",
+		"ecdsa-sha2-nistp384-cert-v01@openssh.com",
+		"ecdsa-sha2-nistp521-cert-v01@openssh.com",
+		"sk-ssh-ed25519-cert-v01@openssh.com",
+		"sk-ecdsa-sha2-nistp256-cert-v01@openssh.com",
+		"rsa-sha2-512-cert-v01@openssh.com",
+		"rsa-sha2-256-cert-v01@openssh.com",
+		"ssh-ed25519",
+		"ecdsa-sha2-nistp256",
+		"ecdsa-sha2-nistp384",
+		"ecdsa-sha2-nistp521",
+		"sk-ssh-ed25519@openssh.com",
+		"sk-ecdsa-sha2-nistp256@openssh.com",
+		"rsa-sha2-512",
+		"rsa-sha2-256",
+	},
+	Cipher: {
+		"chacha20-poly1305@openssh.com",
+		"aes128-ctr",
+		"aes192-ctr",
+		"aes256-ctr",
+		"aes128-gcm@openssh.com",
+		"aes256-gcm@openssh.com",
+	},
+	MAC: {
+		"umac-64-etm@openssh.com",
+		"umac-128-etm@openssh.com",
+		"hmac-sha2-256-etm@openssh.com",
+		"hmac-sha2-512-etm@openssh.com",
+		"hmac-sha1-etm@openssh.com",
+		"umac-64@openssh.com",
+		"umac-128@openssh.com",
+		"hmac-sha2-256",
+		"hmac-sha2-512",
+		"hmac-sha1",
+	},
+}
+
+// keywords maps the configuration keywords that select algorithms, in lower
+// case, to the category they select.
+var keywords = map[string]Category{
+	"kexalgorithms":     KeyExchange,
+	"hostkeyalgorithms": HostKey,
+	"ciphers":           Cipher,
+	"macs":              MAC,
+}
+
+// categories lists the categories in the order they are audited.
+var categories = []Category{KeyExchange, HostKey, Cipher, MAC}
+
+// AuditConfig classifies the algorithms enabled by an sshd_config or
+// ssh_config file. Algorithms the file does not set are taken from the
+// defaults of [DefaultsVersion], and lists beginning with "+", "-" or "^"
+// modify those defaults as described in sshd_config(5).
+//
+// As for [KexInit.Audit], a warning is given if the enabled ciphers and MACs
+// are open to the Terrapin attack (CVE-2023-48795) and strict key exchange is
+// not listed in KexAlgorithms. OpenSSH 9.6 and later always offer strict key
+// exchange, so the warning does not apply to them, but the defaults of
+// [DefaultsVersion], which enable ChaCha20-Poly1305, trigger it.
+//
+// As in OpenSSH, the first value given for each keyword is used. Settings in
+// Match blocks, and in Host blocks other than "Host *", only apply to some
+// connections and are not audited, and Include directives are not followed;
+// both are reported as warnings.
+func AuditConfig(r io.Reader) (*Audit, error) {
+	type setting struct {
+		names []string
+		line  int
+	}
+	settings := make(map[Category]setting)
+	audit := &Audit{}
+
+	scanner := bufio.NewScanner(r)
+	conditional := false
+	for n := 1; scanner.Scan(); n++ {
+		keyword, value := splitDirective(scanner.Text())
+		switch keyword = strings.ToLower(keyword); keyword {
+		case "":
+			continue
+		case "match":
+			conditional = !strings.EqualFold(value, "all")
+			continue
+		case "host":
+			conditional = value != "*"
+			continue
+		case "include":
+			audit.Warnings = append(audit.Warnings, fmt.Sprintf("line %d: Include %s is not audited", n, value))
+			continue
+		}
+
+		category, ok := keywords[keyword]
+		if !ok {
+			continue
+		}
+		if conditional {
+			audit.Warnings = append(audit.Warnings, fmt.Sprintf("line %d: conditional %s is not audited", n, keyword))
+			continue
+		}
+		if _, ok := settings[category]; !ok {
+			settings[category] = setting{names: apply(defaults[category], value), line: n}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[Category][]string)
+	for _, category := range categories {
+		s, ok := settings[category]
+		if !ok {
+			s.names = defaults[category]
+		}
+		enabled[category] = s.names
+		audit.add(category, s.names, s.line)
+	}
+	audit.warnTerrapin("enabled", enabled[KeyExchange], enabled[Cipher], enabled[MAC])
+	return audit, nil
+}
+
+// AuditConfigFile classifies the algorithms enabled by the named sshd_config
+// or ssh_config file, as described for [AuditConfig].
+func AuditConfigFile(name string) (*Audit, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return AuditConfig(f)
+}
+
+// splitDirective splits a configuration line into its keyword and value. The
+// keyword may be separated from the value by whitespace or an equals sign.
+// Comments and blank lines have an empty keyword.
+func splitDirective(line string) (keyword, value string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", ""
+	}
+
+	i := strings.IndexAny(line, " \t=")
+	if i < 0 {
+		return line, ""
+	}
+	keyword, value = line[:i], strings.TrimSpace(line[i:])
+	value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+	return keyword, strings.Trim(value, `"`)
+}
+
+// apply returns the algorithms selected by value, a comma-separated list that
+// replaces the defaults or, if it begins with "+", "-" or "^", appends to,
+// removes from or prepends to them. Removed algorithms may be given as
+// wildcard patterns.
+func apply(defaults []string, value string) []string {
+	if value == "" {
+		return defaults
+	}
+
+	op, list := value[0], strings.Split(value[1:], ",")
+	switch op {
+	case '+':
+		return append(append([]string(nil), defaults...), list...)
+	case '^':
+		return append(list, remove(defaults, list)...)
+	case '-':
+		return remove(defaults, list)
+	default:
+		return strings.Split(value, ",")
+	}
+}
+
+// remove returns the names that do not match any of the patterns.
+func remove(names, patterns []string) []string {
+	var kept []string
+	for _, name := range names {
+		matched := false
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, name); ok {
+				matched = true
+			}
+		}
+		if !matched {
+			kept = append(kept, name)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,178 @@
+package ssh_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/ssh"
+)
+
+func TestAuditConfig(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		config   string
+		category ssh.Category
+		want     []string
+		warnings int
+	}{
+		"defaults": {
+			config:   "Port 22\n",
+			category: ssh.Cipher,
+			want: []string{
+				"chacha20-poly1305@openssh.com",
+				"aes128-ctr",
+				"aes192-ctr",
+				"aes256-ctr",
+				"aes128-gcm@openssh.com",
+				"aes256-gcm@openssh.com",
+			},
+			warnings: 1,
+		},
+		"strict key exchange": {
+			config:   "KexAlgorithms +kex-strict-s-v00@openssh.com\n",
+			category: ssh.Cipher,
+			want: []string{
+				"chacha20-poly1305@openssh.com",
+				"aes128-ctr",
+				"aes192-ctr",
+				"aes256-ctr",
+				"aes128-gcm@openssh.com",
+				"aes256-gcm@openssh.com",
+			},
+		},
+		"cbc with etm": {
+			config:   "Ciphers aes256-cbc\n",
+			category: ssh.Cipher,
+			want:     []string{"aes256-cbc"},
+			warnings: 1,
+		},
+		"replace": {
+			config:   "Ciphers aes256-gcm@openssh.com,aes128-gcm@openssh.com\n",
+			category: ssh.Cipher,
+			want:     []string{"aes256-gcm@openssh.com", "aes128-gcm@openssh.com"},
+		},
+		"append": {
+			config:   "HostKeyAlgorithms +ssh-rsa\n",
+			category: ssh.HostKey,
+			want: []string{
+				"ssh-ed25519-cert-v01@openssh.com",
+				"ecdsa-sha2-nistp256-cert-v01@openssh.com",
+				"ecdsa-sha2-nistp384-cert-v01@openssh.com",
+				"ecdsa-sha2-nistp521-cert-v01@openssh.com",
+				"sk-ssh-ed25519-cert-v01@openssh.com",
+				"sk-ecdsa-sha2-nistp256-cert-v01@openssh.com",
+				"rsa-sha2-512-cert-v01@openssh.com",
+				"rsa-sha2-256-cert-v01@openssh.com",
+				"ssh-ed25519",
+				"ecdsa-sha2-nistp256",
+				"ecdsa-sha2-nistp384",
+				"ecdsa-sha2-nistp521",
+				"sk-ssh-ed25519@openssh.com",
+				"sk-ecdsa-sha2-nistp256@openssh.com",
+				"rsa-sha2-512",
+				"rsa-sha2-256",
+				"ssh-rsa",
+			},
+			warnings: 1,
+		},
+		"remove with wildcard": {
+			config:   "MACs -umac-*,hmac-sha1*\n",
+			category: ssh.MAC,
+			want: []string{
+				"hmac-sha2-256-etm@openssh.com",
+				"hmac-sha2-512-etm@openssh.com",
+				"hmac-sha2-256",
+				"hmac-sha2-512",
+			},
+			warnings: 1,
+		},
+		"prepend": {
+			config:   "Ciphers ^aes256-gcm@openssh.com\n",
+			category: ssh.Cipher,
+			want: []string{
+				"aes256-gcm@openssh.com",
+				"chacha20-poly1305@openssh.com",
+				"aes128-ctr",
+				"aes192-ctr",
+				"aes256-ctr",
+				"aes128-gcm@openssh.com",
+			},
+			warnings: 1,
+		},
+		"first value wins": {
+			config:   "Ciphers aes128-ctr\nCiphers 3des-cbc\n",
+			category: ssh.Cipher,
+			want:     []string{"aes128-ctr"},
+		},
+		"equals sign and case": {
+			config:   "# comment\n  kexalgorithms=curve25519-sha256\n",
+			category: ssh.KeyExchange,
+			want:     []string{"curve25519-sha256"},
+			warnings: 1,
+		},
+		"match block": {
+			config:   "Ciphers aes128-ctr\nMatch User legacy\n  Ciphers 3des-cbc\nMatch all\nMACs hmac-sha2-256\n",
+			category: ssh.Cipher,
+			want:     []string{"aes128-ctr"},
+			warnings: 1,
+		},
+		"host block": {
+			config:   "Host legacy\n  Ciphers 3des-cbc\nHost *\n  Ciphers aes256-ctr\n",
+			category: ssh.Cipher,
+			want:     []string{"aes256-ctr"},
+			warnings: 1,
+		},
+		"include": {
+			config:   "Include /etc/ssh/sshd_config.d/*.conf\nCiphers aes256-ctr\n",
+			category: ssh.Cipher,
+			want:     []string{"aes256-ctr"},
+			warnings: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			audit, err := ssh.AuditConfig(strings.NewReader(tt.config))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, alg := range audit.Algorithms {
+				if alg.Category == tt.category {
+					got = append(got, alg.Name)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+			if len(audit.Warnings) != tt.warnings {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %d warnings", audit.Warnings, tt.warnings)
+			}
+		})
+	}
+}
+
+func TestAuditConfigLine(t *testing.T) {
+	t.Parallel()
+
+	audit, err := ssh.AuditConfig(strings.NewReader("Port 22\n\nCiphers aes128-cbc\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, alg := range audit.Algorithms {
+		want := 0
+		if alg.Category == ssh.Cipher {
+			want = 3
+		}
+		if alg.Line != want {
+			t.Errorf("mismatch for %s:\n  got:  %d\n  want: %d", alg.Name, alg.Line, want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package ssh
+
+import (
+	"errors"
+	"strings"
+)
+
+// msgKexInit is the message number of SSH_MSG_KEXINIT (RFC 4253, section
+// 7.1).
+const msgKexInit = 20
+
+// ErrNotKexInit is returned when a message is not an SSH_MSG_KEXINIT message.
+var ErrNotKexInit = errors.New("ssh: not a KEXINIT message")
+
+// ErrTruncated is returned when a message is shorter than its encoded lengths
+// claim it to be.
+var ErrTruncated = errors.New("ssh: truncated message")
+
+// strictKex are the pseudo-algorithms with which a client and server signal
+// support for the strict key exchange that mitigates the Terrapin attack.
+var strictKex = []string{
+	"kex-strict-c-v00@openssh.com",
+	"kex-strict-s-v00@openssh.com",
+}
+
+// KexInit holds the algorithm lists offered in an SSH_MSG_KEXINIT message.
+type KexInit struct {
+	Cookie                    [16]byte
+	KexAlgorithms             []string
+	ServerHostKeyAlgorithms   []string
+	CiphersClientToServer     []string
+	CiphersServerToClient     []string
+	MACsClientToServer        []string
+	MACsServerToClient        []string
+	CompressionClientToServer []string
+	CompressionServerToClient []string
+	LanguagesClientToServer   []string
+	LanguagesServerToClient   []string
+	FirstKexPacketFollows     bool
+}
+
+// ParseKexInit decodes an SSH_MSG_KEXINIT message. The message may be given
+// either as its payload, starting with the message number, or as the
+// unencrypted binary packet carrying it, starting with the packet length.
+func ParseKexInit(b []byte) (*KexInit, error) {
+	if len(b) > 5 && b[0] != msgKexInit && b[5] == msgKexInit {
+		length := int(b[0])<<24 | int(b[1])<<16 | int(b[2])<<8 | int(b[3])
+		padding := int(b[4])
+		if length < padding+1 || len(b) < 4+length {
+			return nil, ErrTruncated
+		}
+		b = b[5 : 4+length-padding]
+	}
+	if len(b) == 0 || b[0] != msgKexInit {
+		return nil, ErrNotKexInit
+	}
+
+	s := b[1:]
+	if len(s) < len(KexInit{}.Cookie) {
+		return nil, ErrTruncated
+	}
+	k := &KexInit{}
+	copy(k.Cookie[:], s)
+	s = s[len(k.Cookie):]
+
+	lists := []*[]string{
+		&k.KexAlgorithms,
+		&k.ServerHostKeyAlgorithms,
+		&k.CiphersClientToServer,
+		&k.CiphersServerToClient,
+		&k.MACsClientToServer,
+		&k.MACsServerToClient,
+		&k.CompressionClientToServer,
+		&k.CompressionServerToClient,
+		&k.LanguagesClientToServer,
+		&k.LanguagesServerToClient,
+	}
+	for _, list := range lists {
+		if len(s) < 4 {
+			return nil, ErrTruncated
+		}
+		n := int(s[0])<<24 | int(s[1])<<16 | int(s[2])<<8 | int(s[3])
+		if n < 0 || len(s) < 4+n {
+			return nil, ErrTruncated
+		}
+		if n > 0 {
+			*list = strings.Split(string(s[4:4+n]), ",")
+		}
+		s = s[4+n:]
+	}
+
+	// The first_kex_packet_follows flag is followed by four reserved bytes.
+	if len(s) < 5 {
+		return nil, ErrTruncated
+	}
+	k.FirstKexPacketFollows = s[0] != 0
+	return k, nil
+}
+
+// Audit classifies the algorithms offered in the message. Ciphers and MACs are
+// combined across both directions.
+//
+// A warning is given if the message offers algorithms open to the Terrapin
+// attack (CVE-2023-48795), ChaCha20-Poly1305 or encrypt-then-MAC with a CBC
+// cipher, without offering strict key exchange.
+func (k *KexInit) Audit() *Audit {
+	audit := &Audit{}
+	audit.add(KeyExchange, k.KexAlgorithms, 0)
+	audit.add(HostKey, k.ServerHostKeyAlgorithms, 0)
+	audit.add(Cipher, k.CiphersClientToServer, 0)
+	audit.add(Cipher, k.CiphersServerToClient, 0)
+	audit.add(MAC, k.MACsClientToServer, 0)
+	audit.add(MAC, k.MACsServerToClient, 0)
+
+	ciphers := append(append([]string(nil), k.CiphersClientToServer...), k.CiphersServerToClient...)
+	macs := append(append([]string(nil), k.MACsClientToServer...), k.MACsServerToClient...)
+	audit.warnTerrapin("offered", k.KexAlgorithms, ciphers, macs)
+	return audit
+}
+
+// warnTerrapin adds a warning to the audit if the ciphers and MACs include
+// algorithms open to the Terrapin attack and the key exchange algorithms do
+// not include strict key exchange. How describes how the algorithms are
+// made available, such as "offered" or "enabled".
+func (a *Audit) warnTerrapin(how string, kex, ciphers, macs []string) {
+	if offersStrictKex(kex) {
+		return
+	}
+	if alg, ok := terrapinAlgorithm(ciphers, macs); ok {
+		a.Warnings = append(a.Warnings,
+			alg+" is "+how+" without strict key exchange, which leaves it open to the Terrapin attack (CVE-2023-48795)")
+	}
+}
+
+func offersStrictKex(kex []string) bool {
+	for _, name := range kex {
+		for _, strict := range strictKex {
+			if name == strict {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// terrapinAlgorithm returns an algorithm, or combination of algorithms, among
+// the ciphers and MACs that the Terrapin attack applies to.
+func terrapinAlgorithm(ciphers, macs []string) (string, bool) {
+	var cbc, etm string
+	for _, name := range ciphers {
+		if name == "chacha20-poly1305@openssh.com" {
+			return name, true
+		}
+		if cbc == "" && strings.Contains(name, "-cbc") {
+			cbc = name
+		}
+	}
+	for _, name := range macs {
+		if etm == "" && strings.Contains(name, "-etm@") {
+			etm = name
+		}
+	}
+	if cbc != "" && etm != "" {
+		return cbc + " with " + etm, true
+	}
+	return "", false
+}
@@ -0,0 +1,155 @@
+package ssh_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites/ssh"
+)
+
+// marshalKexInit encodes an SSH_MSG_KEXINIT payload offering the given kex
+// algorithms, ciphers and MACs in both directions.
+func marshalKexInit(kex, ciphers, macs []string) []byte {
+	b := []byte{20}
+	b = append(b, make([]byte, 16)...)
+	for _, list := range [][]string{kex, {"ssh-ed25519"}, ciphers, ciphers, macs, macs, {"none"}, {"none"}, nil, nil} {
+		s := strings.Join(list, ",")
+		b = appendUint32(b, len(s))
+		b = append(b, s...)
+	}
+	return append(b, 0, 0, 0, 0, 0)
+}
+
+// packet wraps a payload in an unencrypted binary packet.
+func packet(payload []byte) []byte {
+	padding := 8 - (5+len(payload))%8
+	if padding < 4 {
+		padding += 8
+	}
+	b := appendUint32(nil, 1+len(payload)+padding)
+	b = append(b, byte(padding))
+	b = append(b, payload...)
+	return append(b, make([]byte, padding)...)
+}
+
+func TestParseKexInit(t *testing.T) {
+	t.Parallel()
+
+	payload := marshalKexInit(
+		[]string{"curve25519-sha256", "ext-info-c"},
+		[]string{"aes256-gcm@openssh.com"},
+		[]string{"hmac-sha2-256-etm@openssh.com"},
+	)
+
+	var tests = map[string]struct {
+		message []byte
+		err     error
+	}{
+		"payload": {
+			message: payload,
+		},
+		"binary packet": {
+			message: packet(payload),
+		},
+		"not KEXINIT": {
+			message: []byte{21},
+			err:     ssh.ErrNotKexInit,
+		},
+		"truncated": {
+			message: payload[:40],
+			err:     ssh.ErrTruncated,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			k, err := ssh.ParseKexInit(tt.message)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("mismatch:\n  got:  %v\n  want: %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			want := &ssh.KexInit{
+				KexAlgorithms:             []string{"curve25519-sha256", "ext-info-c"},
+				ServerHostKeyAlgorithms:   []string{"ssh-ed25519"},
+				CiphersClientToServer:     []string{"aes256-gcm@openssh.com"},
+				CiphersServerToClient:     []string{"aes256-gcm@openssh.com"},
+				MACsClientToServer:        []string{"hmac-sha2-256-etm@openssh.com"},
+				MACsServerToClient:        []string{"hmac-sha2-256-etm@openssh.com"},
+				CompressionClientToServer: []string{"none"},
+				CompressionServerToClient: []string{"none"},
+			}
+			if !reflect.DeepEqual(k, want) {
+				t.Errorf("mismatch:\n  got:  %+v\n  want: %+v", k, want)
+			}
+		})
+	}
+}
+
+func TestKexInitAudit(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		kex, ciphers, macs []string
+		algorithms         int
+		terrapin           bool
+	}{
+		"AEAD cipher": {
+			kex:        []string{"curve25519-sha256"},
+			ciphers:    []string{"aes256-gcm@openssh.com"},
+			macs:       []string{"hmac-sha2-256"},
+			algorithms: 4,
+		},
+		"ChaCha20-Poly1305": {
+			kex:        []string{"curve25519-sha256"},
+			ciphers:    []string{"chacha20-poly1305@openssh.com"},
+			macs:       []string{"hmac-sha2-256"},
+			algorithms: 4,
+			terrapin:   true,
+		},
+		"CBC with encrypt-then-MAC": {
+			kex:        []string{"curve25519-sha256"},
+			ciphers:    []string{"aes128-ctr", "aes128-cbc"},
+			macs:       []string{"hmac-sha2-256-etm@openssh.com"},
+			algorithms: 5,
+			terrapin:   true,
+		},
+		"strict key exchange": {
+			kex:        []string{"curve25519-sha256", "kex-strict-s-v00@openssh.com"},
+			ciphers:    []string{"chacha20-poly1305@openssh.com"},
+			macs:       []string{"hmac-sha2-256-etm@openssh.com"},
+			algorithms: 4,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			k, err := ssh.ParseKexInit(marshalKexInit(tt.kex, tt.ciphers, tt.macs))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			audit := k.Audit()
+			if len(audit.Algorithms) != tt.algorithms {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %d algorithms", audit.Algorithms, tt.algorithms)
+			}
+			if got := len(audit.Warnings) > 0; got != tt.terrapin {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", audit.Warnings, tt.terrapin)
+			}
+		})
+	}
+}
+
+func appendUint32(b []byte, n int) []byte {
+	return append(b, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
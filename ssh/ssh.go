@@ -0,0 +1,140 @@
+// Package ssh classifies the algorithms negotiated by the Secure Shell (SSH)
+// protocol using the same levels as TLS cipher suites: recommended, secure,
+// weak and insecure.
+//
+// Key exchange, host key, cipher and MAC algorithms are classified by name,
+// following the IANA Secure Shell (SSH) Protocol Parameters registries, the
+// key exchange method guidance of RFC 9142, and the algorithms OpenSSH has
+// deprecated. Algorithms can be classified one at a time with [Classify], or
+// audited together from an sshd_config or ssh_config file with [AuditConfig],
+// or from a captured SSH_MSG_KEXINIT message with [ParseKexInit].
+package ssh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+// Category is the role an algorithm plays in the SSH protocol.
+type Category string
+
+// Categories of algorithms negotiated by SSH.
+const (
+	KeyExchange Category = "kex"
+	HostKey     Category = "hostkey"
+	Cipher      Category = "cipher"
+	MAC         Category = "mac"
+)
+
+// Algorithm is a classified SSH algorithm.
+type Algorithm struct {
+	Name           string
+	Category       Category
+	Classification ciphersuites.Classification
+	Reason         string
+
+	// Line is the line of the configuration file that enabled the algorithm,
+	// or zero if it was enabled by default or read from a KEXINIT message.
+	Line int
+}
+
+func (a Algorithm) String() string {
+	return fmt.Sprintf("%s %s is classified %s: %s", a.Category, a.Name, a.Classification, a.Reason)
+}
+
+// Classify classifies the named algorithm of the given category. Algorithms
+// that are not known are classified as [ciphersuites.Unknown].
+func Classify(category Category, name string) Algorithm {
+	a := Algorithm{Name: name, Category: category}
+	r, ok := lookup(category, name)
+	if !ok {
+		a.Classification = ciphersuites.Unknown
+		a.Reason = "not a known " + string(category) + " algorithm"
+		return a
+	}
+	a.Classification = r.classification
+	a.Reason = r.reason
+	return a
+}
+
+func lookup(category Category, name string) (rating, bool) {
+	ratings := ratings[category]
+	if r, ok := ratings[name]; ok {
+		return r, true
+	}
+	for pattern, r := range ratings {
+		if strings.HasSuffix(pattern, "*") && strings.HasPrefix(name, strings.TrimSuffix(pattern, "*")) {
+			return r, true
+		}
+	}
+	return rating{}, false
+}
+
+// Audit is the classification of the algorithms enabled by an SSH
+// configuration or offered in a key exchange.
+type Audit struct {
+	// Algorithms lists the classified algorithms in the order of the
+	// categories KeyExchange, HostKey, Cipher and MAC, and within each
+	// category in order of preference.
+	Algorithms []Algorithm
+
+	// Warnings describes weaknesses arising from the combination of
+	// algorithms rather than any one of them.
+	Warnings []string
+}
+
+// Classification returns the classification of the weakest classified
+// algorithm in the audit. Algorithms that cannot be classified are left out,
+// so [ciphersuites.Unknown] is only returned if none of them can be; use
+// [Audit.Below] to find them.
+func (a Audit) Classification() ciphersuites.Classification {
+	weakest := ciphersuites.Unknown
+	for _, alg := range a.Algorithms {
+		if alg.Classification == ciphersuites.Unknown {
+			continue
+		}
+		if weakest == ciphersuites.Unknown || !alg.Classification.AtLeast(weakest) {
+			weakest = alg.Classification
+		}
+	}
+	return weakest
+}
+
+// Below returns the algorithms in the audit that are classified weaker than
+// min.
+func (a Audit) Below(min ciphersuites.Classification) []Algorithm {
+	var below []Algorithm
+	for _, alg := range a.Algorithms {
+		if !alg.Classification.AtLeast(min) {
+			below = append(below, alg)
+		}
+	}
+	return below
+}
+
+// add classifies the named algorithms, skipping pseudo-algorithms and
+// algorithms that have already been added.
+func (a *Audit) add(category Category, names []string, line int) {
+	for _, name := range names {
+		if category == KeyExchange && pseudoAlgorithms[name] {
+			continue
+		}
+		if a.contains(category, name) {
+			continue
+		}
+		alg := Classify(category, name)
+		alg.Line = line
+		a.Algorithms = append(a.Algorithms, alg)
+	}
+}
+
+func (a *Audit) contains(category Category, name string) bool {
+	for _, alg := range a.Algorithms {
+		if alg.Category == category && alg.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package ssh_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+	"github.com/tomasbasham/ciphersuites/ssh"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		category ssh.Category
+		name     string
+		want     ciphersuites.Classification
+	}{
+		"post-quantum key exchange": {
+			category: ssh.KeyExchange,
+			name:     "sntrup761x25519-sha512@openssh.com",
+			want:     ciphersuites.Recommended,
+		},
+		"SHA-1 key exchange": {
+			category: ssh.KeyExchange,
+			name:     "diffie-hellman-group14-sha1",
+			want:     ciphersuites.Weak,
+		},
+		"GSS-API key exchange": {
+			category: ssh.KeyExchange,
+			name:     "gss-curve25519-sha256-toWM5Slw5Ew8Mqkay+al2g==",
+			want:     ciphersuites.Recommended,
+		},
+		"SHA-1 host key signatures": {
+			category: ssh.HostKey,
+			name:     "ssh-rsa",
+			want:     ciphersuites.Insecure,
+		},
+		"1024-bit RSA key exchange": {
+			category: ssh.KeyExchange,
+			name:     "rsa1024-sha1",
+			want:     ciphersuites.Insecure,
+		},
+		"DSA host key": {
+			category: ssh.HostKey,
+			name:     "ssh-dss",
+			want:     ciphersuites.Insecure,
+		},
+		"AEAD cipher": {
+			category: ssh.Cipher,
+			name:     "chacha20-poly1305@openssh.com",
+			want:     ciphersuites.Recommended,
+		},
+		"CBC cipher": {
+			category: ssh.Cipher,
+			name:     "aes128-cbc",
+			want:     ciphersuites.Weak,
+		},
+		"MD5 MAC": {
+			category: ssh.MAC,
+			name:     "hmac-md5",
+			want:     ciphersuites.Insecure,
+		},
+		"wrong category": {
+			category: ssh.MAC,
+			name:     "aes128-ctr",
+			want:     ciphersuites.Unknown,
+		},
+		"unknown algorithm": {
+			category: ssh.Cipher,
+			name:     "made-up-cipher",
+			want:     ciphersuites.Unknown,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ssh.Classify(tt.category, tt.name)
+			if got.Classification != tt.want {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got.Classification, tt.want)
+			}
+			if got.Name != tt.name || got.Category != tt.category {
+				t.Errorf("mismatch:\n  got:  %s %s\n  want: %s %s", got.Category, got.Name, tt.category, tt.name)
+			}
+			if got.Reason == "" {
+				t.Error("expected a reason")
+			}
+		})
+	}
+}
+
+func TestAuditClassification(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		algorithms []ssh.Algorithm
+		want       ciphersuites.Classification
+		below      []string
+	}{
+		"empty": {
+			want: ciphersuites.Unknown,
+		},
+		"weakest algorithm": {
+			algorithms: []ssh.Algorithm{
+				ssh.Classify(ssh.KeyExchange, "curve25519-sha256"),
+				ssh.Classify(ssh.Cipher, "aes128-ctr"),
+				ssh.Classify(ssh.MAC, "hmac-sha1"),
+			},
+			want:  ciphersuites.Weak,
+			below: []string{"hmac-sha1"},
+		},
+		"unknown algorithm": {
+			algorithms: []ssh.Algorithm{
+				ssh.Classify(ssh.KeyExchange, "curve25519-sha256"),
+				ssh.Classify(ssh.Cipher, "made-up-cipher"),
+			},
+			want:  ciphersuites.Recommended,
+			below: []string{"made-up-cipher"},
+		},
+		"insecure algorithm with unknown algorithm": {
+			algorithms: []ssh.Algorithm{
+				ssh.Classify(ssh.KeyExchange, "mlkem1024nistp384-sha384"),
+				ssh.Classify(ssh.Cipher, "none"),
+			},
+			want:  ciphersuites.Insecure,
+			below: []string{"mlkem1024nistp384-sha384", "none"},
+		},
+		"only unknown algorithms": {
+			algorithms: []ssh.Algorithm{
+				ssh.Classify(ssh.Cipher, "made-up-cipher"),
+			},
+			want:  ciphersuites.Unknown,
+			below: []string{"made-up-cipher"},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			audit := ssh.Audit{Algorithms: tt.algorithms}
+			if got := audit.Classification(); got != tt.want {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+
+			var below []string
+			for _, alg := range audit.Below(ciphersuites.Secure) {
+				below = append(below, alg.Name)
+			}
+			if !reflect.DeepEqual(below, tt.below) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", below, tt.below)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package ciphersuites
+
+import (
+	"sort"
+	"strings"
+)
+
+// Score rates the strength of the cipher suite between 0 and 100, allowing
+// cipher suites with the same classification to be ordered. It is the sum of:
+//
+//   - symmetric strength, up to 40, from [CipherSuite.SecurityBits]: 40 for
+//     256 bits or more, 30 for 128 bits or more, 10 for 3DES and 0 for
+//     anything weaker, including CCM_8, RC4, anonymous key exchange and no
+//     encryption;
+//   - authenticated encryption, up to 20: 20 for AEAD ciphers such as GCM,
+//     CCM and ChaCha20-Poly1305, but only 10 for CCM_8, which truncates
+//     its tag to 64 bits;
+//   - forward secrecy, 20 for ephemeral Diffie-Hellman key exchange,
+//     including every TLS 1.3 cipher suite, but not for cipher suites without
+//     encryption or for signalling values such as TLS_FALLBACK_SCSV;
+//   - hash strength, up to 10: 10 for SHA-384 and SHA-512, 8 for SHA-256,
+//     4 for SHA-1 and 0 for MD5; and
+//   - protocol version, up to 10: 10 for cipher suites that can only be used
+//     with TLS 1.3 and 5 for those that require at least TLS 1.2.
+func (a CipherSuite) Score() int {
+	return symmetricScore(a.SecurityBits) +
+		aeadScore(a.EncryptionAlgorithm) +
+		forwardSecrecyScore(a.Name) +
+		hashScore(a.HashAlgorithm) +
+		versionScore(a.TLSVersions)
+}
+
+// Compare orders cipher suites by preference, returning a negative number if
+// a should be preferred over b, a positive number if b should be preferred
+// over a, and zero only if they have the same name. Cipher suites are
+// ordered by classification first, then by [CipherSuite.Score], then by name,
+// so the order is total and deterministic.
+func Compare(a, b CipherSuite) int {
+	if ra, rb := a.Classification.rank(), b.Classification.rank(); ra != rb {
+		return rb - ra
+	}
+	if sa, sb := a.Score(), b.Score(); sa != sb {
+		return sb - sa
+	}
+	return strings.Compare(a.Name, b.Name)
+}
+
+// SortByStrength sorts suites in place from most to least preferred, as
+// defined by [Compare]. The result is suitable as the cipher suite order of a
+// server that enforces its own preference.
+func SortByStrength(suites []CipherSuite) {
+	sort.Slice(suites, func(i, j int) bool {
+		return Compare(suites[i], suites[j]) < 0
+	})
+}
+
+func symmetricScore(bits int) int {
+	switch {
+	case bits >= 256:
+		return 40
+	case bits >= 128:
+		return 30
+	case bits >= 112:
+		return 10
+	default:
+		return 0
+	}
+}
+
+// isAEAD reports whether an encryption algorithm provides authenticated
+// encryption.
+func isAEAD(encryption string) bool {
+	for _, mode := range []string{"GCM", "CCM", "POLY1305", "MGM", "AEGIS", "ASCONAEAD"} {
+		if strings.Contains(encryption, mode) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasShortTag reports whether an AEAD cipher uses a truncated 64-bit tag. The
+// S and L suffixes of the MGM cipher suites select a key update mode, not a
+// tag length.
+func hasShortTag(encryption string) bool {
+	return strings.HasSuffix(encryption, "CCM 8")
+}
+
+func aeadScore(encryption string) int {
+	switch {
+	case !isAEAD(encryption):
+		return 0
+	case hasShortTag(encryption):
+		return 10
+	default:
+		return 20
+	}
+}
+
+func forwardSecrecyScore(name string) int {
+	if strings.Contains(name, "_anon_") || isSignalling(name) || hasNullCipher(name) {
+		return 0
+	}
+	kex, _ := splitKeyExchange(name)
+	switch kex {
+	case "", "DHE", "ECDHE":
+		return 20
+	default:
+		return 0
+	}
+}
+
+// isSignalling reports whether the named cipher suite is a signalling cipher
+// suite value, such as TLS_FALLBACK_SCSV, which names no algorithms at all.
+func isSignalling(name string) bool {
+	return strings.HasSuffix(name, "_SCSV")
+}
+
+// hasNullCipher reports whether the named cipher suite provides no
+// encryption. These are the NULL cipher suites of TLS 1.2 and earlier, and
+// the integrity-only TLS 1.3 cipher suites of RFC 9150, such as
+// TLS_SHA256_SHA256, which do not name a key exchange either.
+func hasNullCipher(name string) bool {
+	if strings.Contains(name, "_WITH_") {
+		return strings.Contains(name, "_WITH_NULL_")
+	}
+	return strings.HasPrefix(name, "TLS_SHA")
+}
+
+func hashScore(hash string) int {
+	switch hash {
+	case "SHA384", "SHA512":
+		return 10
+	case "SHA256", "SM3", "ASCONHASH256":
+		return 8
+	case "SHA":
+		return 4
+	default:
+		return 0
+	}
+}
+
+// versionRanks orders the protocol versions named in
+// [CipherSuite.TLSVersions].
+var versionRanks = map[string]int{
+	"SSL3.0": 1,
+	"TLS1.0": 2,
+	"TLS1.1": 3,
+	"TLS1.2": 4,
+	"TLS1.3": 5,
+}
+
+func versionScore(versions []string) int {
+	lowest := 0
+	for _, v := range versions {
+		if r, ok := versionRanks[v]; ok && (lowest == 0 || r < lowest) {
+			lowest = r
+		}
+	}
+	switch lowest {
+	case versionRanks["TLS1.3"]:
+		return 10
+	case versionRanks["TLS1.2"]:
+		return 5
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,164 @@
+package ciphersuites_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite string
+		want        int
+	}{
+		"TLS 1.3 with 256 bit AEAD": {
+			cipherSuite: "TLS_AES_256_GCM_SHA384",
+			want:        100,
+		},
+		"TLS 1.2 with forward secrecy and AEAD": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+			want:        83,
+		},
+		"truncated tag": {
+			cipherSuite: "TLS_AES_128_CCM_8_SHA256",
+			want:        48,
+		},
+		"static RSA with CBC": {
+			cipherSuite: "TLS_RSA_WITH_AES_128_CBC_SHA",
+			want:        34,
+		},
+		"3DES": {
+			cipherSuite: "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+			want:        14,
+		},
+		"RC4": {
+			cipherSuite: "TLS_RSA_WITH_RC4_128_MD5",
+			want:        0,
+		},
+		"NULL cipher with forward secrecy": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_NULL_SHA",
+			want:        4,
+		},
+		"integrity-only TLS 1.3": {
+			cipherSuite: "TLS_SHA256_SHA256",
+			want:        13,
+		},
+		"fallback signalling value": {
+			cipherSuite: "TLS_FALLBACK_SCSV",
+			want:        0,
+		},
+		"renegotiation signalling value": {
+			cipherSuite: "TLS_EMPTY_RENEGOTIATION_INFO_SCSV",
+			want:        0,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cs, ok := ciphersuites.GetCipherSuite(tt.cipherSuite)
+			if !ok {
+				t.Fatal("cipher suite not found")
+			}
+			if got := cs.Score(); got != tt.want {
+				t.Errorf("mismatch:\n  got:  %d\n  want: %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortByStrength(t *testing.T) {
+	t.Parallel()
+
+	var suites []ciphersuites.CipherSuite
+	for _, name := range []string{
+		"TLS_RSA_WITH_RC4_128_SHA",
+		"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+		"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+		"TLS_AES_256_GCM_SHA384",
+		"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	} {
+		cs, ok := ciphersuites.GetCipherSuite(name)
+		if !ok {
+			t.Fatalf("cipher suite %s not found", name)
+		}
+		suites = append(suites, cs)
+	}
+
+	ciphersuites.SortByStrength(suites)
+
+	want := []string{
+		"TLS_AES_256_GCM_SHA384",
+		"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+		"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		"TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+		"TLS_RSA_WITH_RC4_128_SHA",
+	}
+	if got := suiteNames(suites); !reflect.DeepEqual(got, want) {
+		t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	suites := ciphersuites.CipherSuites()
+	for i := 1; i < len(suites); i++ {
+		a, b := suites[i-1], suites[i]
+		if got, reverse := ciphersuites.Compare(a, b), ciphersuites.Compare(b, a); got == 0 || got > 0 == (reverse > 0) {
+			t.Errorf("mismatch:\n  got:  Compare(%s, %s) = %d and %d reversed\n  want: a total order", a.Name, b.Name, got, reverse)
+		}
+	}
+	if got := ciphersuites.Compare(suites[0], suites[0]); got != 0 {
+		t.Errorf("mismatch:\n  got:  %d\n  want: 0", got)
+	}
+}
+
+func TestSuitesWithStrengthAtLeast(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		bits    int
+		include string
+		exclude string
+	}{
+		"128 bits excludes 3DES": {
+			bits:    128,
+			include: "TLS_AES_128_GCM_SHA256",
+			exclude: "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+		},
+		"128 bits excludes truncated tags": {
+			bits:    128,
+			include: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM",
+			exclude: "TLS_ECDHE_ECDSA_WITH_AES_256_CCM_8",
+		},
+		"256 bits excludes AES 128": {
+			bits:    256,
+			include: "TLS_AES_256_GCM_SHA384",
+			exclude: "TLS_AES_128_GCM_SHA256",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found := make(map[string]bool)
+			for _, cs := range ciphersuites.SuitesWithStrengthAtLeast(tt.bits) {
+				found[cs.Name] = true
+			}
+			if !found[tt.include] {
+				t.Errorf("mismatch:\n  got:  %s excluded\n  want: included", tt.include)
+			}
+			if found[tt.exclude] {
+				t.Errorf("mismatch:\n  got:  %s included\n  want: excluded", tt.exclude)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package ciphersuites
+
+import (
+	"crypto/tls"
+	"fmt"
+)
+
+// VersionName returns the name of a TLS protocol version as it appears in
+// [CipherSuite.TLSVersions], for example "TLS1.2". Unknown versions are
+// returned as their hexadecimal value.
+func VersionName(version uint16) string {
+	switch version {
+	case tls.VersionSSL30:
+		return "SSL3.0"
+	case tls.VersionTLS10:
+		return "TLS1.0"
+	case tls.VersionTLS11:
+		return "TLS1.1"
+	case tls.VersionTLS12:
+		return "TLS1.2"
+	case tls.VersionTLS13:
+		return "TLS1.3"
+	default:
+		return fmt.Sprintf("0x%04X", version)
+	}
+}
+
+// protocolVersions lists the protocol versions named in
+// [CipherSuite.TLSVersions], oldest first.
+var protocolVersions = []uint16{
+	tls.VersionSSL30,
+	tls.VersionTLS10,
+	tls.VersionTLS11,
+	tls.VersionTLS12,
+	tls.VersionTLS13,
+}
+
+// NegotiableAt reports whether the cipher suite can be negotiated at the
+// given protocol version. TLS 1.3 only negotiates the cipher suites defined
+// for it, which cannot be negotiated at earlier versions, regardless of what
+// TLSVersions lists.
+func (a CipherSuite) NegotiableAt(version uint16) bool {
+	tls13 := len(a.TLSVersions) == 1 && a.TLSVersions[0] == "TLS1.3"
+	if version == tls.VersionTLS13 || tls13 {
+		return version == tls.VersionTLS13 && tls13
+	}
+
+	name := VersionName(version)
+	for _, v := range a.TLSVersions {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NegotiableVersions returns the names of the protocol versions at which the
+// cipher suite can be negotiated, as reported by [CipherSuite.NegotiableAt],
+// oldest first.
+func (a CipherSuite) NegotiableVersions() []string {
+	var versions []string
+	for _, v := range protocolVersions {
+		if a.NegotiableAt(v) {
+			versions = append(versions, VersionName(v))
+		}
+	}
+	return versions
+}
+
+// ClassificationAt returns the classification of the cipher suite when
+// negotiated at the given protocol version. Versions before TLS 1.2 are
+// deprecated by RFC 8996, so the cipher suite is insecure when negotiated at
+// them, as it is at any version that cannot negotiate it.
+func (a CipherSuite) ClassificationAt(version uint16) Classification {
+	if version < tls.VersionTLS12 || !a.NegotiableAt(version) {
+		return Insecure
+	}
+	return a.Classification
+}
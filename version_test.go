@@ -0,0 +1,123 @@
+package ciphersuites_test
+
+import (
+	"crypto/tls"
+	"reflect"
+	"testing"
+
+	"github.com/tomasbasham/ciphersuites"
+)
+
+func TestVersionName(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		version uint16
+		want    string
+	}{
+		"returns tls 1.0": {
+			version: tls.VersionTLS10,
+			want:    "TLS1.0",
+		},
+		"returns tls 1.3": {
+			version: tls.VersionTLS13,
+			want:    "TLS1.3",
+		},
+		"returns hexadecimal": {
+			version: 0x7F1C,
+			want:    "0x7F1C",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ciphersuites.VersionName(tt.version)
+			if got != tt.want {
+				t.Errorf("mismatch:\n  got:  %q\n  want: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteNegotiableVersions(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite string
+		want        []string
+	}{
+		"tls 1.3 suite is only negotiable at tls 1.3": {
+			cipherSuite: "TLS_AES_128_GCM_SHA256",
+			want:        []string{"TLS1.3"},
+		},
+		"aead suite is not negotiable at tls 1.3": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+			want:        []string{"TLS1.2"},
+		},
+		"cbc suite is not negotiable at tls 1.3": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+			want:        []string{"TLS1.0", "TLS1.1", "TLS1.2"},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cs, ok := ciphersuites.GetCipherSuite(tt.cipherSuite)
+			if !ok {
+				t.Fatalf("%s is not registered", tt.cipherSuite)
+			}
+			if got := cs.NegotiableVersions(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteClassificationAt(t *testing.T) {
+	t.Parallel()
+
+	var tests = map[string]struct {
+		cipherSuite string
+		version     uint16
+		want        ciphersuites.Classification
+	}{
+		"recommended suite at tls 1.3": {
+			cipherSuite: "TLS_AES_128_GCM_SHA256",
+			version:     tls.VersionTLS13,
+			want:        ciphersuites.Recommended,
+		},
+		"weak suite at tls 1.2": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+			version:     tls.VersionTLS12,
+			want:        ciphersuites.Weak,
+		},
+		"weak suite at deprecated tls 1.0": {
+			cipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+			version:     tls.VersionTLS10,
+			want:        ciphersuites.Insecure,
+		},
+		"tls 1.3 suite at tls 1.2": {
+			cipherSuite: "TLS_AES_128_GCM_SHA256",
+			version:     tls.VersionTLS12,
+			want:        ciphersuites.Insecure,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cs, ok := ciphersuites.GetCipherSuite(tt.cipherSuite)
+			if !ok {
+				t.Fatalf("%s is not registered", tt.cipherSuite)
+			}
+			if got := cs.ClassificationAt(tt.version); got != tt.want {
+				t.Errorf("mismatch:\n  got:  %v\n  want: %v", got, tt.want)
+			}
+		})
+	}
+}